@@ -0,0 +1,135 @@
+package keyboard
+
+import (
+	"fmt"
+	"strings"
+)
+
+// KeyboardEvent is an event delivered by ListenAll: either an Event for a
+// key or an AbsEvent for an absolute axis.
+type KeyboardEvent interface {
+	isKeyboardEvent()
+}
+
+func (Event) isKeyboardEvent() {}
+
+// AbsEvent describes a change of an absolute axis, as reported by joysticks
+// and graphics tablets.
+type AbsEvent struct {
+	// Axis is the evdev code name for the axis (e.g., "ABS_X").
+	Axis string
+	// Value is the new position of the axis.
+	Value int32
+}
+
+func (AbsEvent) isKeyboardEvent() {}
+
+// Direction selects which threshold crossings trigger an axis binding.
+type Direction int
+
+const (
+	// Rising fires when the axis value rises from below the threshold to at
+	// or above it.
+	Rising Direction = iota
+	// Falling fires when the axis value falls from above the threshold to at
+	// or below it.
+	Falling
+)
+
+// String returns the string representation of the Direction.
+func (d Direction) String() string {
+	switch d {
+	case Rising:
+		return "Rising"
+	case Falling:
+		return "Falling"
+	default:
+		return "Unknown"
+	}
+}
+
+// axisBinding is a callback fired when an axis crosses a threshold.
+type axisBinding struct {
+	id        BindingID
+	axis      string
+	threshold int32
+	direction Direction
+	cb        BindingCallback
+}
+
+// crossed reports whether moving from prev to cur crosses the threshold of
+// b in its direction.
+func (b *axisBinding) crossed(prev, cur int32) bool {
+	if b.direction == Falling {
+		return prev > b.threshold && cur <= b.threshold
+	}
+	return prev < b.threshold && cur >= b.threshold
+}
+
+// axisCode resolves s to its evdev axis code name, accepting short forms
+// without the "ABS_" prefix (e.g., "X" resolves to "ABS_X"). It returns an
+// empty string if s does not name a known axis.
+func axisCode(s string) string {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if axisNames[s] {
+		return s
+	}
+	if axisNames["ABS_"+s] {
+		return "ABS_" + s
+	}
+	return ""
+}
+
+// RegisterAxisBinding registers cb to fire whenever axis (e.g., "ABS_X")
+// crosses threshold in the given direction. A crossing is only detected
+// once a previous value of the axis has been seen.
+func (m *Manager) RegisterAxisBinding(axis string, threshold int32, direction Direction, cb BindingCallback) (BindingID, error) {
+	code := axisCode(axis)
+	if code == "" {
+		return 0, fmt.Errorf("%w: unknown axis %q", ErrKeyNameInvalid, axis)
+	}
+	if cb == nil {
+		return 0, fmt.Errorf("%w: axis %q", ErrNilCallback, axis)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	m.axisBindings = append(m.axisBindings, &axisBinding{
+		id:        m.nextID,
+		axis:      code,
+		threshold: threshold,
+		direction: direction,
+		cb:        cb,
+	})
+	return m.nextID, nil
+}
+
+// HandleAbsEvent processes an absolute axis event and invokes the callbacks
+// of all axis bindings whose threshold it crosses.
+func (m *Manager) HandleAbsEvent(ev AbsEvent) {
+	m.mu.Lock()
+	prev, seen := m.axes[ev.Axis]
+	m.axes[ev.Axis] = ev.Value
+	var calls []pendingCall
+	if seen {
+		for _, b := range m.axisBindings {
+			if b.axis == ev.Axis && b.crossed(prev, ev.Value) {
+				calls = append(calls, pendingCall{combo: b.axis, cb: b.cb, async: true})
+			}
+		}
+	}
+	m.mu.Unlock()
+
+	m.dispatch(calls)
+}
+
+// HandleKeyboardEvent passes ev to HandleEvent or HandleAbsEvent depending
+// on its type.
+func (m *Manager) HandleKeyboardEvent(ev KeyboardEvent) {
+	switch e := ev.(type) {
+	case Event:
+		m.HandleEvent(e)
+	case AbsEvent:
+		m.HandleAbsEvent(e)
+	}
+}
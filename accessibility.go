@@ -0,0 +1,195 @@
+package keyboard
+
+import "time"
+
+// AccessibilityConfig holds the keyboard accessibility settings applied by
+// SetAccessibility, named after their AT-SPI2 and X11 AccessX
+// counterparts. The zero value disables all of them.
+type AccessibilityConfig struct {
+	// SlowKeysDelay, if set, makes a key press count only once the key has
+	// been held this long; keys released earlier are ignored.
+	SlowKeysDelay time.Duration `json:"slow_keys_delay,omitempty"`
+	// BounceKeysThreshold, if set, ignores a press of a key less than this
+	// long after the same key was released.
+	BounceKeysThreshold time.Duration `json:"bounce_keys_threshold,omitempty"`
+	// StickyKeysEnabled latches a modifier key that is pressed and released
+	// without pressing another key, so it applies to the keys pressed
+	// afterwards as if still held. Pressing a latched modifier again
+	// unlatches it.
+	StickyKeysEnabled bool `json:"sticky_keys_enabled,omitempty"`
+	// OneShotModifiers makes latched modifiers apply only to the next key
+	// press, after which they are released.
+	OneShotModifiers bool `json:"one_shot_modifiers,omitempty"`
+}
+
+// accessibility is the state of the accessibility features of a Manager.
+type accessibility struct {
+	cfg       AccessibilityConfig
+	released  map[string]time.Time  // keys to their last release, for bounce keys
+	bounced   map[string]bool       // keys whose ignored bounce press is still down
+	slow      map[string]*slowPress // keys whose press awaits the slow keys delay
+	down      map[string]bool       // modifier keys physically held, for sticky keys
+	used      map[string]bool       // held modifier keys that modified another key
+	latched   map[string]bool       // modifier keys latched by sticky keys
+	unlatched map[string]bool       // latched modifier keys pressed again to unlatch
+}
+
+// slowPress is a key press awaiting the slow keys delay.
+type slowPress struct {
+	timer *time.Timer // handles the press once the delay has passed
+}
+
+// reset stops pending slow keys timers and forgets all state, keeping the
+// configuration.
+func (a *accessibility) reset() {
+	for _, p := range a.slow {
+		p.timer.Stop()
+	}
+	a.released = make(map[string]time.Time)
+	a.bounced = make(map[string]bool)
+	a.slow = make(map[string]*slowPress)
+	a.down = make(map[string]bool)
+	a.used = make(map[string]bool)
+	a.latched = make(map[string]bool)
+	a.unlatched = make(map[string]bool)
+}
+
+// SetAccessibility replaces all accessibility settings at once with cfg,
+// e.g. as loaded from the desktop's accessibility configuration. Presses
+// awaiting the slow keys delay are dropped and latched modifiers stay
+// pressed until released.
+func (m *Manager) SetAccessibility(cfg AccessibilityConfig) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.a11y.reset()
+	m.a11y.cfg = cfg
+}
+
+// Accessibility returns the settings applied by SetAccessibility.
+func (m *Manager) Accessibility() AccessibilityConfig {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.a11y.cfg
+}
+
+// handleAccessible applies the accessibility settings to ev and passes the
+// resulting events to processEvent. The caller must hold m.mu.
+func (m *Manager) handleAccessible(ev Event) {
+	a := &m.a11y
+	if ev.Key == "" || a.cfg == (AccessibilityConfig{}) {
+		m.processEvent(ev)
+		return
+	}
+	key, now := ev.Key, time.Now()
+
+	if a.cfg.BounceKeysThreshold > 0 {
+		switch ev.Type {
+		case Press:
+			if at, ok := a.released[key]; ok && now.Sub(at) < a.cfg.BounceKeysThreshold {
+				a.bounced[key] = true
+				return
+			}
+		case Hold:
+			if a.bounced[key] {
+				return
+			}
+		case Release:
+			a.released[key] = now
+			if a.bounced[key] {
+				delete(a.bounced, key)
+				return
+			}
+		}
+	}
+
+	if a.cfg.SlowKeysDelay > 0 {
+		switch ev.Type {
+		case Press:
+			if _, pending := a.slow[key]; !pending {
+				p := &slowPress{}
+				p.timer = time.AfterFunc(a.cfg.SlowKeysDelay, func() { m.acceptSlowKey(ev, p) })
+				a.slow[key] = p
+			}
+			return
+		case Hold:
+			if _, pending := a.slow[key]; pending {
+				return
+			}
+		case Release:
+			if p, pending := a.slow[key]; pending {
+				p.timer.Stop()
+				delete(a.slow, key)
+				return
+			}
+		}
+	}
+
+	m.handleSticky(ev)
+}
+
+// acceptSlowKey handles the press ev once its key has been held for the
+// slow keys delay, unless p is no longer the key's pending press.
+func (m *Manager) acceptSlowKey(ev Event, p *slowPress) {
+	m.mu.Lock()
+	if m.a11y.slow[ev.Key] != p {
+		m.mu.Unlock()
+		return
+	}
+	delete(m.a11y.slow, ev.Key)
+	m.handleSticky(ev)
+	calls := m.pending
+	m.pending = nil
+	unknown := m.unknownKeys
+	m.unknownKeys = nil
+	m.mu.Unlock()
+
+	m.hooks.unknownKeys(unknown)
+	m.dispatch(calls)
+}
+
+// handleSticky applies sticky keys to ev and passes the resulting events to
+// processEvent. The caller must hold m.mu.
+func (m *Manager) handleSticky(ev Event) {
+	a := &m.a11y
+	if !a.cfg.StickyKeysEnabled {
+		m.processEvent(ev)
+		return
+	}
+	key := ev.Key
+	if _, mod := m.modifierOf(key); mod {
+		switch ev.Type {
+		case Press:
+			a.down[key] = true
+			delete(a.used, key)
+			if a.latched[key] {
+				delete(a.latched, key)
+				a.unlatched[key] = true
+				return
+			}
+		case Release:
+			delete(a.down, key)
+			if a.unlatched[key] {
+				delete(a.unlatched, key)
+			} else if !a.used[key] {
+				// keep the modifier pressed until unlatched
+				a.latched[key] = true
+				return
+			}
+		}
+		m.processEvent(ev)
+		return
+	}
+
+	if ev.Type == Press {
+		for held := range a.down {
+			a.used[held] = true
+		}
+	}
+	m.processEvent(ev)
+	if ev.Type == Press && a.cfg.OneShotModifiers {
+		for mod := range a.latched {
+			delete(a.latched, mod)
+			m.processEvent(Event{Key: mod, Type: Release})
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Package auditlog records keyboard events to a tamper-evident,
+// append-only log, for environments where keyboard input must be
+// auditable. Every record is signed with an HMAC covering the record and
+// the signature of the record before it, so that modifying or reordering
+// records, or removing any but the last ones, breaks the chain checked by
+// Verify.
+package auditlog
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+)
+
+// ErrTampered is returned by Verify for a log whose records do not match
+// their signatures.
+var ErrTampered = errors.New("audit log has been tampered with")
+
+// Record is a single line of the audit log, encoded as JSON.
+type Record struct {
+	// Time is when the event was logged.
+	Time time.Time `json:"time"`
+	// Key is the evdev code name of the key (e.g., "KEY_A").
+	Key string `json:"key"`
+	// Type is the event type (e.g., "Press").
+	Type string `json:"type"`
+	// Prev is the hex-encoded MAC of the previous record, empty for the
+	// first one.
+	Prev string `json:"prev"`
+	// MAC is the hex-encoded HMAC-SHA256 over Prev and the other fields.
+	MAC string `json:"mac,omitempty"`
+}
+
+// Option configures an AuditLogger created by NewAuditLogger.
+type Option func(*AuditLogger)
+
+// WithKey sets the secret key signing the records. By default a random key
+// is generated, which must be retrieved with Key to verify the log later.
+func WithKey(key []byte) Option {
+	return func(l *AuditLogger) {
+		l.key = append([]byte(nil), key...)
+	}
+}
+
+// AuditLogger writes a signed Record for every event it is given. It is
+// safe for concurrent use.
+type AuditLogger struct {
+	mu   sync.Mutex
+	w    io.Writer
+	key  []byte
+	prev string // MAC of the last record written
+	err  error  // first write error
+}
+
+// NewAuditLogger returns an AuditLogger appending records to w, one JSON
+// object per line.
+func NewAuditLogger(w io.Writer, opts ...Option) *AuditLogger {
+	l := &AuditLogger{w: w}
+	for _, opt := range opts {
+		opt(l)
+	}
+	if len(l.key) == 0 {
+		l.key = make([]byte, sha256.Size)
+		rand.Read(l.key)
+	}
+	return l
+}
+
+// Key returns a copy of the secret key signing the records.
+func (l *AuditLogger) Key() []byte {
+	return append([]byte(nil), l.key...)
+}
+
+// Log writes a signed record for ev.
+func (l *AuditLogger) Log(ev keyboard.Event) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rec := Record{Time: time.Now().UTC(), Key: ev.Key, Type: ev.Type.String(), Prev: l.prev}
+	rec.MAC = sign(l.key, rec)
+	line, err := json.Marshal(rec)
+	if err == nil {
+		_, err = l.w.Write(append(line, '\n'))
+	}
+	if err != nil {
+		if l.err == nil {
+			l.err = err
+		}
+		return err
+	}
+	l.prev = rec.MAC
+	return nil
+}
+
+// WrapChannel returns a channel delivering the events of src unchanged,
+// after logging each of them. It is closed once src is closed. Events are
+// delivered even if they cannot be logged; Err reports the first failure.
+func (l *AuditLogger) WrapChannel(src <-chan keyboard.Event) <-chan keyboard.Event {
+	out := make(chan keyboard.Event)
+	go func() {
+		defer close(out)
+		for ev := range src {
+			l.Log(ev)
+			out <- ev
+		}
+	}()
+	return out
+}
+
+// Err returns the first error writing a record, or nil.
+func (l *AuditLogger) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// Verify reads an audit log written with key from r and checks the
+// signature of every record and the chain linking them, returning an error
+// wrapping ErrTampered for the first record that does not match.
+func Verify(r io.Reader, key []byte) error {
+	sc := bufio.NewScanner(r)
+	prev := ""
+	for n := 1; sc.Scan(); n++ {
+		var rec Record
+		if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+			return fmt.Errorf("%w: record %d: %w", ErrTampered, n, err)
+		}
+		if rec.Prev != prev {
+			return fmt.Errorf("%w: record %d does not follow record %d", ErrTampered, n, n-1)
+		}
+		if !hmac.Equal([]byte(rec.MAC), []byte(sign(key, rec))) {
+			return fmt.Errorf("%w: record %d has an invalid signature", ErrTampered, n)
+		}
+		prev = rec.MAC
+	}
+	return sc.Err()
+}
+
+// sign returns the hex-encoded HMAC of rec, ignoring its MAC field.
+func sign(key []byte, rec Record) string {
+	rec.MAC = ""
+	data, _ := json.Marshal(rec)
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(rec.Prev))
+	h.Write(data)
+	return hex.EncodeToString(h.Sum(nil))
+}
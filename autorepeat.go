@@ -0,0 +1,73 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"fmt"
+	"os"
+	"time"
+	"unsafe"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// ReadAutoRepeat returns the key repeat delay and period configured for the
+// input device at path.
+func ReadAutoRepeat(path string) (delay, period time.Duration, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer f.Close()
+	var rep [2]uint32 // REP_DELAY and REP_PERIOD in milliseconds
+	if err := ioctl(f.Fd(), evioc(iocRead, 0x03, unsafe.Sizeof(rep)), unsafe.Pointer(&rep)); err != nil {
+		return 0, 0, fmt.Errorf("%w %s: reading auto-repeat: %w", ErrDeviceRead, path, err)
+	}
+	delay = time.Duration(rep[0]) * time.Millisecond
+	period = time.Duration(rep[1]) * time.Millisecond
+	return delay, period, nil
+}
+
+// SetAutoRepeat sets the key repeat delay and period of the input device at
+// path by writing EV_REP events to it. Both are rounded down to milliseconds.
+func SetAutoRepeat(path string, delay, period time.Duration) error {
+	if delay < 0 || period < 0 {
+		return fmt.Errorf("%w: auto-repeat delay %v or period %v", ErrInvalidArgument, delay, period)
+	}
+	dev, err := evdev.OpenWithFlags(path, os.O_WRONLY)
+	if err != nil {
+		return fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer dev.Close()
+	for _, ev := range []evdev.InputEvent{
+		{Type: evdev.EV_REP, Code: evdev.REP_DELAY, Value: int32(delay.Milliseconds())},
+		{Type: evdev.EV_REP, Code: evdev.REP_PERIOD, Value: int32(period.Milliseconds())},
+	} {
+		if err := dev.WriteOne(&ev); err != nil {
+			return fmt.Errorf("%w %s: setting auto-repeat: %w", ErrDeviceWrite, path, err)
+		}
+	}
+	return nil
+}
+
+// GetAutoRepeat returns the key repeat delay and period of the device the
+// Manager is listening to. The Manager must have been created with
+// NewManagerForDevice.
+func (m *Manager) GetAutoRepeat() (delay, period time.Duration, err error) {
+	path, err := m.devicePathOrErr()
+	if err != nil {
+		return 0, 0, err
+	}
+	return ReadAutoRepeat(path)
+}
+
+// SetAutoRepeat sets the key repeat delay and period of the device the
+// Manager is listening to. The Manager must have been created with
+// NewManagerForDevice.
+func (m *Manager) SetAutoRepeat(delay, period time.Duration) error {
+	path, err := m.devicePathOrErr()
+	if err != nil {
+		return err
+	}
+	return SetAutoRepeat(path, delay, period)
+}
@@ -0,0 +1,98 @@
+package keyboard
+
+import (
+	"context"
+	"sync"
+)
+
+// Backend is a source of keyboard events consumed by Manager.Run. The
+// default backend reads the first detected keyboard through evdev on Linux
+// and is a StubBackend elsewhere.
+type Backend interface {
+	// Listen starts delivering events on the returned channel until ctx is
+	// done or the source fails, and then closes the channel.
+	Listen(ctx context.Context) (<-chan Event, error)
+}
+
+// WithBackend sets the Backend that Run reads events from.
+func WithBackend(b Backend) ManagerOption {
+	return func(m *Manager) {
+		m.backend = b
+	}
+}
+
+// Run reads events from the Manager's Backend and handles them until ctx is
+// done or the event stream ends. It returns ctx.Err() if ctx is done, the
+// error of the Backend if it cannot listen, and nil if the stream ended.
+func (m *Manager) Run(ctx context.Context) error {
+	b := m.backend
+	if b == nil {
+		b = defaultBackend()
+	}
+	events, err := b.Listen(ctx)
+	if err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ev, ok := <-events:
+			if !ok {
+				return ctx.Err()
+			}
+			m.HandleEvent(ev)
+		}
+	}
+}
+
+// MockBackend is a Backend delivering the events passed to Send, for tests
+// on any platform.
+type MockBackend struct {
+	events    chan Event
+	closeOnce sync.Once
+}
+
+// NewMockBackend returns a MockBackend with no events queued.
+func NewMockBackend() *MockBackend {
+	return &MockBackend{events: make(chan Event)}
+}
+
+// Listen returns a channel delivering the events passed to Send until ctx
+// is done or Close is called. Only one listener should be active at a time.
+func (b *MockBackend) Listen(ctx context.Context) (<-chan Event, error) {
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-b.events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- ev:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// Send delivers evs to the active listener, blocking until it receives
+// them.
+func (b *MockBackend) Send(evs ...Event) {
+	for _, ev := range evs {
+		b.events <- ev
+	}
+}
+
+// Close ends the event stream of the active listener. No events may be
+// sent afterwards.
+func (b *MockBackend) Close() {
+	b.closeOnce.Do(func() { close(b.events) })
+}
@@ -0,0 +1,35 @@
+//go:build linux
+
+package keyboard
+
+import "context"
+
+// LinuxEvdevBackend is a Backend reading a keyboard through evdev.
+type LinuxEvdevBackend struct {
+	// Path is the input device to read. If empty, the first detected
+	// keyboard is used.
+	Path string
+	// Options configure the event stream, as for Listen.
+	Options []ListenOption
+}
+
+// Listen opens the keyboard and streams its events until ctx is done.
+func (b *LinuxEvdevBackend) Listen(ctx context.Context) (<-chan Event, error) {
+	path := b.Path
+	if path == "" {
+		var err error
+		if path, err = findFirstKeyboard(); err != nil {
+			return nil, err
+		}
+	}
+	opts := append(b.Options[:len(b.Options):len(b.Options)], WithContext(ctx))
+	h, err := listenPath(path, newListenConfig(opts))
+	if err != nil {
+		return nil, err
+	}
+	return h.Events, nil
+}
+
+func defaultBackend() Backend {
+	return &LinuxEvdevBackend{}
+}
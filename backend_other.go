@@ -0,0 +1,18 @@
+//go:build !linux
+
+package keyboard
+
+import "context"
+
+// StubBackend is the Backend used on platforms without evdev. It never
+// delivers events.
+type StubBackend struct{}
+
+// Listen returns ErrNotSupported.
+func (StubBackend) Listen(ctx context.Context) (<-chan Event, error) {
+	return nil, ErrNotSupported
+}
+
+func defaultBackend() Backend {
+	return StubBackend{}
+}
@@ -0,0 +1,170 @@
+package keyboard
+
+import (
+	"fmt"
+	"testing"
+)
+
+// benchManager returns a Manager with Sync bindings for combos and for n
+// other combos of a modifier and a function key, up to 120.
+func benchManager(b *testing.B, n int, combos ...string) *Manager {
+	b.Helper()
+	for i := range n {
+		mods := []string{"CTRL", "SHIFT", "ALT", "META", "CTRL+SHIFT"}[i/24]
+		combos = append(combos, fmt.Sprintf("%s+F%d", mods, i%24+1))
+	}
+	m, _ := newCountingManager(b, combos)
+	return m
+}
+
+// benchHandle measures handling evs with m, one batch per iteration.
+func benchHandle(b *testing.B, m *Manager, evs []Event) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for range b.N {
+		m.HandleEvent(evs...)
+	}
+}
+
+func BenchmarkHandleEvent(b *testing.B) {
+	benchHandle(b, benchManager(b, 0, "CTRL+B"), tap("KEY_A"))
+}
+
+func BenchmarkHandleEventWithMatch(b *testing.B) {
+	benchHandle(b, benchManager(b, 0, "CTRL+A"), []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL")})
+}
+
+func BenchmarkHandleEventWildcard(b *testing.B) {
+	benchHandle(b, benchManager(b, 0, "*+A"), []Event{press("KEY_LEFTSHIFT"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTSHIFT")})
+}
+
+func BenchmarkHandleEventTenBindings(b *testing.B) {
+	benchHandle(b, benchManager(b, 10, "CTRL+A"), []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL")})
+}
+
+func BenchmarkHandleEventHundredBindings(b *testing.B) {
+	benchHandle(b, benchManager(b, 100, "CTRL+A"), []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL")})
+}
+
+func BenchmarkConcurrentHandleEvent(b *testing.B) {
+	m := NewManager()
+	m.RegisterBinding("CTRL+A", func() {}, ReentrantCallback())
+	evs := []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A")}
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.HandleEvent(evs...)
+		}
+	})
+}
+
+func BenchmarkHandleEventModifierRepeat(b *testing.B) {
+	repeat := []Event{hold("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL")}
+	for _, bc := range []struct {
+		name string
+		opts []ManagerOption
+	}{
+		{"Default", nil},
+		{"IgnoreRepeatModifiers", []ManagerOption{IgnoreRepeatModifiers()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			m, _ := newCountingManager(b, []string{"CTRL+A"}, bc.opts...)
+			m.HandleEvent(press("KEY_LEFTCTRL"))
+			benchHandle(b, m, repeat)
+		})
+	}
+}
+
+func BenchmarkHandleEventModifierBitmask(b *testing.B) {
+	evs := []Event{press("KEY_LEFTCTRL"), press("KEY_LEFTSHIFT"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTSHIFT"), release("KEY_LEFTCTRL")}
+	for _, bc := range []struct {
+		name string
+		opts []ManagerOption
+	}{
+		{"Maps", nil},
+		{"Bitmask", []ManagerOption{UseModifierBitmask()}},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			m, _ := newCountingManager(b, []string{"CTRL+SHIFT+A"}, bc.opts...)
+			benchHandle(b, m, evs)
+		})
+	}
+}
+
+func BenchmarkIsPressed(b *testing.B) {
+	// KEY_A is read from the lock-free bitmask, BTN_LEFT under the lock,
+	// while another goroutine handles events
+	for _, key := range []string{"KEY_A", "BTN_LEFT"} {
+		b.Run(key, func(b *testing.B) {
+			m := NewManager()
+			m.HandleEvent(press(key))
+			stop := make(chan struct{})
+			defer close(stop)
+			go func() {
+				for {
+					select {
+					case <-stop:
+						return
+					default:
+						m.HandleEvent(tap("KEY_B")...)
+					}
+				}
+			}()
+			b.ReportAllocs()
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					m.IsPressed(key)
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkParseCombo(b *testing.B) {
+	const combo = "ctrl+shift+alt+t"
+	b.Run("Cached", func(b *testing.B) {
+		ParseCombo(combo)
+		b.ReportAllocs()
+		for range b.N {
+			ParseCombo(combo)
+		}
+	})
+	b.Run("Uncached", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			parseCombo(combo, isModifierName)
+		}
+	})
+}
+
+func BenchmarkConcurrentReads(b *testing.B) {
+	// readers share the lock with each other, and only wait for the
+	// goroutine handling events and registering bindings
+	m := NewManager()
+	m.RegisterBinding("CTRL+A", func() {}, ReentrantCallback())
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL"))
+				id, _ := m.RegisterSpec(BindingSpec{Combo: "SHIFT+B", Callback: func() {}})
+				m.Unregister(id)
+			}
+		}
+	}()
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			m.CurrentlyPressed()
+			m.InferCombo()
+			m.ActiveModifiers()
+		}
+	})
+}
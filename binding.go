@@ -0,0 +1,736 @@
+package keyboard
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// BindingID identifies a binding registered with a Manager.
+type BindingID uint64
+
+// CallbackMode selects how the callbacks of a binding are invoked.
+type CallbackMode int
+
+const (
+	// Async invokes callbacks in a new goroutine. This is the default.
+	Async CallbackMode = iota
+	// Sync invokes callbacks in the goroutine calling HandleEvent while the
+	// Manager's lock is held, so callbacks run strictly in event order.
+	// A Sync callback must not call any Manager method, or it will deadlock.
+	Sync
+	// SyncReentrant invokes callbacks in the goroutine calling HandleEvent
+	// after the Manager's lock has been released. Callbacks still run in
+	// event order and may safely call Manager methods.
+	SyncReentrant
+)
+
+// BindingOption configures a BindingSpec registered with RegisterBinding.
+type BindingOption func(*BindingSpec)
+
+// SyncCallback makes the binding invoke its callbacks synchronously inside
+// HandleEvent instead of in a new goroutine. The callbacks run while the
+// Manager's lock is held and must not call Manager methods; see
+// ReentrantCallback for a variant without that restriction.
+func SyncCallback() BindingOption {
+	return func(s *BindingSpec) { s.Mode = Sync }
+}
+
+// ReentrantCallback makes the binding invoke its callbacks synchronously at
+// the end of HandleEvent, after the Manager's lock has been released.
+func ReentrantCallback() BindingOption {
+	return func(s *BindingSpec) { s.Mode = SyncReentrant }
+}
+
+// WithFireOnRelease makes the binding fire its Callback when the trigger
+// key is released while the rest of the combo is still held, instead of
+// when the combo is pressed, e.g. for push-to-talk. See
+// BindingSpec.FireOnRelease.
+func WithFireOnRelease() BindingOption {
+	return func(s *BindingSpec) { s.FireOnRelease = true }
+}
+
+// BindingSpec describes a key combination binding together with all of its
+// options. Apart from the callbacks, a BindingSpec can be serialized to and
+// from configuration files.
+type BindingSpec struct {
+	// Combo is the key combination (e.g., "CTRL+ALT+T").
+	Combo string `json:"combo"`
+	// Callback is invoked when the combo is pressed.
+	Callback BindingCallback `json:"-"`
+	// OnRelease is invoked when the trigger key is released after Callback fired.
+	OnRelease BindingCallback `json:"-"`
+	// HoldThreshold delays Callback until the combo has been held this long.
+	// Releasing the trigger key earlier cancels the callback.
+	HoldThreshold time.Duration `json:"hold_threshold,omitempty"`
+	// TickInterval, if set, fires Callback on press and then repeatedly at
+	// this interval for as long as the combo is held, independent of the
+	// kernel key repeat rate. HoldThreshold is ignored for ticker bindings.
+	TickInterval time.Duration `json:"tick_interval,omitempty"`
+	// SuppressRepeat prevents Callback from firing again until the trigger key
+	// is released.
+	SuppressRepeat bool `json:"suppress_repeat,omitempty"`
+	// Group is an optional group name used to enable or disable bindings together.
+	Group string `json:"group,omitempty"`
+	// OneShot removes the binding after Callback has fired once.
+	OneShot bool `json:"one_shot,omitempty"`
+	// Priority orders bindings matching the same key press; higher values are
+	// checked first. Only the highest-priority match fires unless it sets
+	// ContinuePropagation.
+	Priority int `json:"priority,omitempty"`
+	// ContinuePropagation lets lower-priority matching bindings fire after
+	// this one.
+	ContinuePropagation bool `json:"continue_propagation,omitempty"`
+	// FireOnRelease delays Callback until the trigger key is released,
+	// firing it only if the modifiers and other keys of the combo are still
+	// held, the binding is still registered and its group enabled. The press of the combo is still handled by the binding, so it
+	// stops lower-priority bindings as usual. HoldThreshold and
+	// TickInterval are ignored for such bindings.
+	FireOnRelease bool `json:"fire_on_release,omitempty"`
+	// Mode selects how Callback and OnRelease are invoked on key events.
+	// Callbacks fired by HoldThreshold and TickInterval timers always run on
+	// the timer's goroutine.
+	Mode CallbackMode `json:"mode,omitempty"`
+}
+
+// binding is a registered BindingSpec along with its runtime state.
+type binding struct {
+	id      BindingID
+	spec    BindingSpec
+	combo   string        // normalized combo
+	trigger string        // non-modifier part of the combo
+	active  bool          // combo pressed and trigger not yet released
+	fired   bool          // Callback fired during the current activation
+	timer   *time.Timer   // pending HoldThreshold timer
+	holdGen uint64        // incremented for every HoldThreshold timer started
+	tick    chan struct{} // closed to stop the running TickInterval ticker
+	waiter  bool          // registered by WaitForCombo and hidden from introspection
+}
+
+// RegisterSpec registers a binding described by spec and returns its ID.
+// It returns an error if the combo is invalid or the spec has no callbacks.
+func (m *Manager) RegisterSpec(spec BindingSpec) (BindingID, error) {
+	return m.registerSpecs([]BindingSpec{spec})
+}
+
+// RegisterBindingMap registers each callback in bindings for its combo, in
+// the sorted order of the combos, e.g. to load bindings from a config map.
+// It returns the errors of the combos that could not be registered, or nil
+// if all were.
+func (m *Manager) RegisterBindingMap(bindings map[string]BindingCallback) []error {
+	combos := make([]string, 0, len(bindings))
+	for combo := range bindings {
+		combos = append(combos, combo)
+	}
+	slices.Sort(combos)
+	var errs []error
+	for _, combo := range combos {
+		if _, err := m.RegisterSpec(BindingSpec{Combo: combo, Callback: bindings[combo]}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// registerSpecs registers a binding for each of specs, all sharing a single
+// ID so they can be unregistered together. Nothing is registered if any spec
+// is invalid.
+func (m *Manager) registerSpecs(specs []BindingSpec) (BindingID, error) {
+	norms := make([]string, len(specs))
+	for i, spec := range specs {
+		norm, err := m.parseCombo(spec.Combo)
+		if err != nil {
+			return 0, err
+		}
+		if spec.Callback == nil && spec.OnRelease == nil {
+			return 0, fmt.Errorf("%w: combo %q", ErrNilCallback, spec.Combo)
+		}
+		norms[i] = norm
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nextID++
+	for i, spec := range specs {
+		m.insertBinding(&binding{
+			id:      m.nextID,
+			spec:    spec,
+			combo:   norms[i],
+			trigger: comboTrigger(norms[i]),
+		})
+		m.publish(BindingRegisteredEvent{Combo: norms[i]})
+	}
+	return m.nextID, nil
+}
+
+// Unregister removes the bindings with the given ID, cancelling their
+// pending hold callbacks, tickers and release callbacks, and reports
+// whether any were registered.
+func (m *Manager) Unregister(id BindingID) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var found []*binding
+	for _, list := range m.bindings {
+		for _, b := range list {
+			if b.id == id {
+				found = append(found, b)
+			}
+		}
+	}
+	for _, b := range found {
+		m.discardBinding(b)
+	}
+	if len(found) > 0 {
+		return true
+	}
+	for i, b := range m.axisBindings {
+		if b.id == id {
+			m.axisBindings = slices.Delete(m.axisBindings, i, i+1)
+			return true
+		}
+	}
+	return false
+}
+
+// UnregisterCombo removes all bindings registered for combo, which is
+// normalized first, so "ALT+CTRL+T" removes bindings for "CTRL+ALT+T".
+// Their pending hold callbacks, tickers and release callbacks are cancelled.
+// It returns ErrBindingNotFound if there are none.
+func (m *Manager) UnregisterCombo(combo string) error {
+	norm, err := m.parseCombo(combo)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.bindings[norm]) == 0 {
+		return fmt.Errorf("%w for %q", ErrBindingNotFound, combo)
+	}
+	for _, b := range slices.Clone(m.bindings[norm]) {
+		m.discardBinding(b)
+	}
+	return nil
+}
+
+// UnregisterAll removes every registered binding in a single operation.
+// Events handled afterwards simply match no bindings until new ones are
+// registered, and pending hold or release callbacks are discarded.
+func (m *Manager) UnregisterAll() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, b := range m.active {
+		m.deactivate(b)
+	}
+	m.active = nil
+	m.bindings = make(map[string][]*binding)
+	m.axisBindings = nil
+}
+
+// RegisterHoldTicker registers cb to fire when combo is pressed and then
+// every interval for as long as it is held. Kernel key repeat (Hold) events
+// are ignored; the ticker stops when the trigger key is released.
+func (m *Manager) RegisterHoldTicker(combo string, interval time.Duration, cb BindingCallback) (BindingID, error) {
+	if interval <= 0 {
+		return 0, fmt.Errorf("%w: hold ticker interval %v", ErrInvalidArgument, interval)
+	}
+	return m.RegisterSpec(BindingSpec{Combo: combo, Callback: cb, TickInterval: interval})
+}
+
+// FireCombo invokes the callbacks of the bindings matching combo as if it
+// had been pressed, without any key events or changes to the pressed key
+// state, e.g. for a GUI button performing the same action as a shortcut.
+// Bindings are tried in priority order like on a key press, skipping
+// disabled groups, and hold thresholds and tickers are ignored. Callback
+// hooks receive a zero Event. It returns ErrBindingNotFound if no enabled
+// binding with a Callback matches.
+func (m *Manager) FireCombo(combo string) error {
+	norm, err := m.parseCombo(combo)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	prev := m.current
+	m.current = Event{}
+	fired := false
+	for _, b := range m.matchingBindings(norm) {
+		if b.spec.Callback == nil || m.groupDisabled(b) {
+			continue
+		}
+		fired = true
+		idx := m.bindingIndex(b)
+		if b.spec.OneShot {
+			m.removeBinding(b)
+		}
+		m.notifyFired(b, idx)
+		m.invoke(b, b.spec.Callback)
+		if !b.spec.ContinuePropagation {
+			break
+		}
+	}
+	m.current = prev
+	calls := m.pending
+	m.pending = nil
+	m.mu.Unlock()
+
+	m.dispatch(calls)
+	if !fired {
+		return fmt.Errorf("%w for %q", ErrBindingNotFound, combo)
+	}
+	return nil
+}
+
+// EnableGroup re-enables all bindings in group after a call to DisableGroup.
+func (m *Manager) EnableGroup(group string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.disabledGroups, group)
+}
+
+// DisableGroup prevents all bindings in group from firing until the group
+// is enabled again.
+func (m *Manager) DisableGroup(group string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.disabledGroups[group] = true
+}
+
+// ListGroups returns the distinct group names of the registered bindings,
+// sorted, including disabled groups.
+func (m *Manager) ListGroups() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var groups []string
+	for _, list := range m.bindings {
+		for _, b := range list {
+			if g := b.spec.Group; g != "" && !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+	slices.Sort(groups)
+	return groups
+}
+
+// GroupBindings returns the normalized combos of the bindings in group,
+// sorted, each listed once.
+func (m *Manager) GroupBindings(group string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var combos []string
+	for combo, list := range m.bindings {
+		if slices.ContainsFunc(list, func(b *binding) bool { return b.spec.Group == group }) {
+			combos = append(combos, combo)
+		}
+	}
+	slices.Sort(combos)
+	return combos
+}
+
+// UnregisterAllInGroup removes all bindings in group under a single
+// acquisition of the lock, so no event sees only some of them removed, and
+// returns how many were removed. Their pending hold callbacks, tickers and
+// release callbacks are cancelled. Together with RegisterBindingMap this
+// replaces a group when reloading its configuration.
+func (m *Manager) UnregisterAllInGroup(group string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var found []*binding
+	for _, list := range m.bindings {
+		for _, b := range list {
+			if b.spec.Group == group {
+				found = append(found, b)
+			}
+		}
+	}
+	for _, b := range found {
+		m.discardBinding(b)
+	}
+	return len(found)
+}
+
+// visibleBindings returns the bindings in list other than those registered
+// by WaitForCombo, which String, Snapshot and ValidateBindings do not report.
+func visibleBindings(list []*binding) []*binding {
+	if !slices.ContainsFunc(list, func(b *binding) bool { return b.waiter }) {
+		return list
+	}
+	return slices.DeleteFunc(slices.Clone(list), func(b *binding) bool { return b.waiter })
+}
+
+// insertBinding adds b to the registered bindings, keeping each combo's list
+// ordered by descending priority. The caller must hold m.mu.
+func (m *Manager) insertBinding(b *binding) {
+	list := m.bindings[b.combo]
+	i := len(list)
+	for i > 0 && list[i-1].spec.Priority < b.spec.Priority {
+		i--
+	}
+	m.bindings[b.combo] = slices.Insert(list, i, b)
+}
+
+// removeBinding deletes b from the registered bindings. The caller must hold m.mu.
+func (m *Manager) removeBinding(b *binding) {
+	list := m.bindings[b.combo]
+	for i, other := range list {
+		if other == b {
+			list = append(list[:i:i], list[i+1:]...)
+			break
+		}
+	}
+	if len(list) == 0 {
+		delete(m.bindings, b.combo)
+	} else {
+		m.bindings[b.combo] = list
+	}
+}
+
+// discardBinding removes b from the registered bindings and ends its
+// activation, so that no hold timer, ticker or release callback of b fires
+// afterwards. The caller must hold m.mu.
+func (m *Manager) discardBinding(b *binding) {
+	m.removeBinding(b)
+	if i := slices.Index(m.active, b); i >= 0 {
+		m.active = slices.Delete(m.active, i, i+1)
+	}
+	m.deactivate(b)
+}
+
+// pressBinding fires b for a press of its combo and reports whether b
+// handled the press. The caller must hold m.mu.
+func (m *Manager) pressBinding(b *binding) bool {
+	if m.groupDisabled(b) {
+		return false
+	}
+	if b.spec.SuppressRepeat && b.active {
+		return true
+	}
+	if !b.active {
+		b.active = true
+		m.active = append(m.active, b)
+	}
+	if b.spec.FireOnRelease {
+		return true
+	}
+	if b.spec.TickInterval > 0 {
+		if b.tick == nil && b.spec.Callback != nil {
+			b.fired = true
+			b.tick = make(chan struct{})
+			cb, ev, stop := b.spec.Callback, m.current, b.tick
+			m.notifyFired(b, m.bindingIndex(b))
+			m.timers.Add(1)
+			go func() {
+				defer m.timers.Done()
+				runTicker(b.spec.TickInterval, func() { m.call(b.combo, ev, cb) }, stop)
+			}()
+		}
+		return true
+	}
+	if b.spec.HoldThreshold > 0 {
+		if b.timer == nil {
+			b.holdGen++
+			gen, ev := b.holdGen, m.current
+			m.timers.Add(1)
+			b.timer = time.AfterFunc(b.spec.HoldThreshold, func() {
+				defer m.timers.Done()
+				m.fireHeld(b, gen, ev)
+			})
+		}
+		return true
+	}
+	b.fired = true
+	idx := m.bindingIndex(b)
+	if b.spec.OneShot {
+		m.removeBinding(b)
+	}
+	if b.spec.Callback != nil {
+		m.notifyFired(b, idx)
+		m.invoke(b, b.spec.Callback)
+	}
+	return true
+}
+
+// groupDisabled reports whether b belongs to a disabled group. The caller
+// must hold m.mu.
+func (m *Manager) groupDisabled(b *binding) bool {
+	return b.spec.Group != "" && m.disabledGroups[b.spec.Group]
+}
+
+// bindingIndex returns the position of b among the bindings for its combo,
+// or -1 if it is no longer registered. The caller must hold m.mu.
+func (m *Manager) bindingIndex(b *binding) int {
+	return slices.Index(m.bindings[b.combo], b)
+}
+
+// pendingCall is a callback matched while m.mu was held, to be dispatched
+// once the lock has been released.
+type pendingCall struct {
+	combo string // combo or axis the callback is bound to
+	ev    Event  // event that matched the combo
+	cb    BindingCallback
+	async bool // run in a new goroutine rather than inline
+}
+
+// invoke schedules cb according to the callback mode of b. Sync callbacks
+// run immediately; all others are queued and dispatched by HandleEvent after
+// it releases the lock. The caller must hold m.mu.
+func (m *Manager) invoke(b *binding, cb BindingCallback) {
+	if b.spec.Mode == Sync {
+		m.call(b.combo, m.current, cb)
+		return
+	}
+	m.pending = append(m.pending, pendingCall{
+		combo: b.combo,
+		ev:    m.current,
+		cb:    cb,
+		async: b.spec.Mode != SyncReentrant,
+	})
+}
+
+// dispatch runs callbacks queued by invoke, in order, without holding m.mu.
+func (m *Manager) dispatch(calls []pendingCall) {
+	for _, c := range calls {
+		if c.async {
+			go m.call(c.combo, c.ev, c.cb)
+		} else {
+			m.call(c.combo, c.ev, c.cb)
+		}
+	}
+}
+
+// call runs the callback cb bound to combo and matched by ev, between the
+// hooks added with OnBeforeCallback and OnAfterCallback. It logs a warning
+// if cb runs longer than the Manager's callback timeout.
+func (m *Manager) call(combo string, ev Event, cb BindingCallback) {
+	m.running.Add(1)
+	defer m.running.Add(-1)
+	before, after := m.hooks.get()
+	for _, hook := range before {
+		hook(combo, ev)
+	}
+	start := time.Now()
+	if timeout := m.CallbackTimeout(); timeout > 0 {
+		t := time.AfterFunc(timeout, func() {
+			m.logger.Warn("callback exceeded timeout",
+				"combo", combo, "timeout", timeout, "elapsed", time.Since(start))
+		})
+		defer t.Stop()
+	}
+	cb()
+	if len(after) > 0 {
+		d := time.Since(start)
+		for _, hook := range after {
+			hook(combo, d)
+		}
+	}
+}
+
+// fireHeld invokes the callback of b once the HoldThreshold timer of
+// generation gen expires, unless the trigger key was released in the meantime.
+// ev is the Press event that started the timer.
+func (m *Manager) fireHeld(b *binding, gen uint64, ev Event) {
+	m.mu.Lock()
+	if !b.active || b.timer == nil || b.holdGen != gen {
+		m.mu.Unlock()
+		return
+	}
+	b.timer = nil
+	b.fired = true
+	idx := m.bindingIndex(b)
+	if b.spec.OneShot {
+		m.removeBinding(b)
+	}
+	m.mu.Unlock()
+	if b.spec.Callback != nil {
+		m.notifyFired(b, idx)
+		m.call(b.combo, ev, b.spec.Callback)
+	}
+}
+
+// runTicker invokes cb immediately and then at every interval until stop
+// is closed.
+func runTicker(interval time.Duration, cb BindingCallback, stop <-chan struct{}) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	cb()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+			cb()
+		}
+	}
+}
+
+// deactivate ends the current activation of b, stopping any pending hold
+// timer or running ticker. The caller must hold m.mu.
+func (m *Manager) deactivate(b *binding) {
+	if b.timer != nil {
+		if b.timer.Stop() {
+			m.timers.Done()
+		}
+		b.timer = nil
+	}
+	if b.tick != nil {
+		close(b.tick)
+		b.tick = nil
+	}
+	b.active = false
+	b.fired = false
+}
+
+// releaseBindings ends the activation of all bindings triggered by trigger,
+// cancelling pending hold timers and invoking the Callback of FireOnRelease
+// bindings and OnRelease callbacks. The caller must hold m.mu.
+func (m *Manager) releaseBindings(trigger string) {
+	kept := m.active[:0]
+	for _, b := range m.active {
+		if b.trigger != trigger {
+			kept = append(kept, b)
+			continue
+		}
+		if b.spec.FireOnRelease && !m.groupDisabled(b) && m.comboHeld(b) {
+			b.fired = true
+			idx := m.bindingIndex(b)
+			if b.spec.OneShot {
+				m.removeBinding(b)
+			}
+			if b.spec.Callback != nil {
+				m.notifyFired(b, idx)
+				m.invoke(b, b.spec.Callback)
+			}
+		}
+		if b.fired && b.spec.OnRelease != nil {
+			m.invoke(b, b.spec.OnRelease)
+		}
+		m.deactivate(b)
+	}
+	for i := len(kept); i < len(m.active); i++ {
+		m.active[i] = nil
+	}
+	m.active = kept
+}
+
+// comboHeld reports whether the modifiers and keys of the combo of b, other
+// than its trigger, are all held. The caller must hold m.mu.
+func (m *Manager) comboHeld(b *binding) bool {
+	parts := strings.Split(b.combo, "+")
+	for _, p := range parts[:len(parts)-1] {
+		switch {
+		case p == wildcard:
+		case m.isModifierName(p):
+			if !m.keys.isModifierHeld(p) {
+				return false
+			}
+		default:
+			if !m.keys.isHeld(keyCode(p)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchingBindings returns the bindings for combo followed by the wildcard
+// bindings for its trigger key, ordered by descending priority. Bindings of
+// equal priority keep their registration order, with exact combos first.
+// The caller must hold m.mu.
+func (m *Manager) matchingBindings(combo string) []*binding {
+	exact := m.bindings[combo]
+	wild := m.bindings[wildcard+"+"+comboTrigger(combo)]
+	if len(wild) == 0 {
+		return exact
+	}
+	list := make([]*binding, 0, len(exact)+len(wild))
+	list = append(list, exact...)
+	list = append(list, wild...)
+	slices.SortStableFunc(list, func(a, b *binding) int {
+		return cmp.Compare(b.spec.Priority, a.spec.Priority)
+	})
+	return list
+}
+
+// PossibleCompletions returns the keys that would complete a registered combo
+// if pressed while pressedKeys are held. Pressed keys may be given as modifier
+// names (e.g., "CTRL"), key codes (e.g., "KEY_LEFTCTRL" or "KEY_A") or short
+// key names (e.g., "A"), with non-modifier keys in the order they were
+// pressed. For example, with "CTRL+A" and "CTRL+B" registered,
+// PossibleCompletions([]string{"CTRL"}) returns ["A", "B"], and with
+// "CTRL+A+B" registered, PossibleCompletions([]string{"CTRL", "A"}) returns
+// ["B"]. Combos without further keys remain possible while other keys are
+// held, as pressing their trigger still fires them. The result is sorted
+// and contains no duplicates.
+func (m *Manager) PossibleCompletions(pressedKeys []string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	held := make(map[string]bool, len(pressedKeys))
+	var keys []string // held non-modifier keys by short name
+	for _, k := range pressedKeys {
+		k = strings.ToUpper(strings.TrimSpace(k))
+		if name, ok := m.modifierOf(k); ok {
+			k = name
+		}
+		if m.isModifierName(k) {
+			held[k] = true
+			continue
+		}
+		if code := keyCode(k); code != "" {
+			k = keyName(code)
+		}
+		keys = append(keys, k)
+	}
+
+	seen := make(map[string]bool)
+	for combo, list := range m.bindings {
+		if !m.anyEnabled(list) {
+			continue
+		}
+		parts := strings.Split(combo, "+")
+		trigger := parts[len(parts)-1]
+		if len(parts) == 2 && parts[0] == wildcard {
+			seen[trigger] = true
+			continue
+		}
+		comboKeys := m.comboKeys(combo)
+		mods := parts[:len(parts)-len(comboKeys)]
+		prefix := comboKeys[:len(comboKeys)-1]
+		if !sameModifiers(mods, held) || (len(prefix) > 0 && !slices.Equal(prefix, keys)) {
+			continue
+		}
+		if !slices.Contains(keys, trigger) {
+			seen[trigger] = true
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for k := range seen {
+		out = append(out, k)
+	}
+	slices.Sort(out)
+	return out
+}
+
+// anyEnabled reports whether any binding in list belongs to an enabled
+// group. The caller must hold m.mu.
+func (m *Manager) anyEnabled(list []*binding) bool {
+	for _, b := range list {
+		if !m.groupDisabled(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// sameModifiers reports whether mods contains exactly the modifiers in held.
+func sameModifiers(mods []string, held map[string]bool) bool {
+	if len(mods) != len(held) {
+		return false
+	}
+	for _, mod := range mods {
+		if !held[mod] {
+			return false
+		}
+	}
+	return true
+}
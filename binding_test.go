@@ -0,0 +1,207 @@
+package keyboard
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// countingSpec returns a spec for combo whose Callback increments n. Its
+// callbacks run in HandleEvent, or in the goroutine of the ticker or hold
+// timer, so that n is up to date once those have stopped.
+func countingSpec(combo string, n *atomic.Int64) BindingSpec {
+	return BindingSpec{Combo: combo, Callback: func() { n.Add(1) }, Mode: Sync}
+}
+
+// waitTimers waits until every ticker and hold timer of m has stopped, so
+// that none of their callbacks can run afterwards.
+func waitTimers(t *testing.T, m *Manager) {
+	t.Helper()
+	done := make(chan struct{})
+	go func() {
+		m.timers.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("tickers or hold timers still running")
+	}
+}
+
+// waitFired waits until ch has received n signals of a callback.
+func waitFired(t *testing.T, ch <-chan struct{}, n int) {
+	t.Helper()
+	for i := range n {
+		select {
+		case <-ch:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("callback fired %d times, want %d", i, n)
+		}
+	}
+}
+
+func TestUnregisterComboStopsTicker(t *testing.T) {
+	m := NewManager()
+	ticks := make(chan struct{}, 100)
+	spec := BindingSpec{Combo: "CTRL+A", Callback: func() { ticks <- struct{}{} }, TickInterval: time.Millisecond}
+	if _, err := m.RegisterSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+	waitFired(t, ticks, 3)
+	if err := m.UnregisterCombo("ctrl+a"); err != nil {
+		t.Fatalf("UnregisterCombo: %v", err)
+	}
+	// returns once the ticker goroutine has exited
+	waitTimers(t, m)
+	if len(m.active) != 0 {
+		t.Errorf("%d bindings still active after UnregisterCombo", len(m.active))
+	}
+}
+
+func TestUnregisterComboCancelsHold(t *testing.T) {
+	m := NewManager()
+	var n atomic.Int64
+	spec := countingSpec("CTRL+A", &n)
+	spec.HoldThreshold = 10 * time.Millisecond
+	if _, err := m.RegisterSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+	if err := m.UnregisterCombo("CTRL+A"); err != nil {
+		t.Fatalf("UnregisterCombo: %v", err)
+	}
+	waitTimers(t, m)
+	if got := n.Load(); got != 0 {
+		t.Errorf("hold callback fired %d times after UnregisterCombo", got)
+	}
+}
+
+func TestUnregisterAllInGroupEndsActivation(t *testing.T) {
+	m := NewManager()
+	var ticks, released atomic.Int64
+	spec := countingSpec("CTRL+A", &ticks)
+	spec.Group, spec.TickInterval = "editor", time.Millisecond
+	spec.OnRelease = func() { released.Add(1) }
+	keep := countingSpec("CTRL+B", new(atomic.Int64))
+	if _, err := m.RegisterSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.RegisterSpec(keep); err != nil {
+		t.Fatal(err)
+	}
+	m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+	if n := m.UnregisterAllInGroup("editor"); n != 1 {
+		t.Fatalf("UnregisterAllInGroup = %d, want 1", n)
+	}
+	waitTimers(t, m)
+	m.HandleEvent(release("KEY_A"))
+	if got := released.Load(); got != 0 {
+		t.Errorf("OnRelease fired %d times after UnregisterAllInGroup", got)
+	}
+	if got := m.GroupBindings(""); len(got) != 1 || got[0] != "CTRL+B" {
+		t.Errorf("remaining bindings = %v, want [CTRL+B]", got)
+	}
+}
+
+func TestFireOnReleaseAfterRemoval(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		remove func(m *Manager, id BindingID)
+	}{
+		{"UnregisterCombo", func(m *Manager, _ BindingID) { m.UnregisterCombo("CTRL+A") }},
+		{"Unregister", func(m *Manager, id BindingID) { m.Unregister(id) }},
+		{"UnregisterAllInGroup", func(m *Manager, _ BindingID) { m.UnregisterAllInGroup("ptt") }},
+		{"DisableGroup", func(m *Manager, _ BindingID) { m.DisableGroup("ptt") }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, fired := newCountingManager(t, nil)
+			id, err := m.RegisterSpec(BindingSpec{
+				Combo:         "CTRL+A",
+				Callback:      func() { fired["CTRL+A"]++ },
+				Group:         "ptt",
+				FireOnRelease: true,
+				Mode:          Sync,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+			tc.remove(m, id)
+			m.HandleEvent(release("KEY_A"))
+			if fired["CTRL+A"] != 0 {
+				t.Errorf("FireOnRelease binding fired %d times after %s", fired["CTRL+A"], tc.name)
+			}
+		})
+	}
+}
+
+func TestFireOnRelease(t *testing.T) {
+	m, fired := newCountingManager(t, nil)
+	m.RegisterBinding("CTRL+A", func() { fired["CTRL+A"]++ }, WithFireOnRelease(), SyncCallback())
+	m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+	if fired["CTRL+A"] != 0 {
+		t.Fatalf("FireOnRelease binding fired on press")
+	}
+	m.HandleEvent(release("KEY_A"), press("KEY_A"), release("KEY_LEFTCTRL"), release("KEY_A"))
+	if fired["CTRL+A"] != 1 {
+		t.Errorf("FireOnRelease binding fired %d times, want 1", fired["CTRL+A"])
+	}
+}
+
+func TestPossibleCompletions(t *testing.T) {
+	m := NewManager()
+	for _, combo := range []string{"CTRL+A", "CTRL+B", "CTRL+A+B", "A+C", "SHIFT+D", "*+E"} {
+		m.RegisterBinding(combo, func() {})
+	}
+	for _, tc := range []struct {
+		pressed []string
+		want    []string
+	}{
+		{nil, []string{"E"}},
+		{[]string{"CTRL"}, []string{"A", "B", "E"}},
+		{[]string{"KEY_LEFTCTRL"}, []string{"A", "B", "E"}},
+		{[]string{"CTRL", "A"}, []string{"B", "E"}},
+		{[]string{"CTRL", "KEY_A"}, []string{"B", "E"}},
+		{[]string{"CTRL", "B"}, []string{"A", "E"}},
+		{[]string{"A"}, []string{"C", "E"}},
+		{[]string{"shift"}, []string{"D", "E"}},
+		{[]string{"CTRL", "SHIFT"}, []string{"E"}},
+	} {
+		got := m.PossibleCompletions(tc.pressed)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("PossibleCompletions(%q) = %q, want %q", tc.pressed, got, tc.want)
+		}
+	}
+}
+
+func TestHoldRepeatsOnlyWithTicker(t *testing.T) {
+	for _, opts := range [][]ManagerOption{nil, {WithSuppressRepeats()}} {
+		m := NewManager(opts...)
+		var n atomic.Int64
+		if _, err := m.RegisterSpec(countingSpec("CTRL+A", &n)); err != nil {
+			t.Fatal(err)
+		}
+		m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+		for range 10 {
+			m.HandleEvent(hold("KEY_A"))
+		}
+		if got := n.Load(); got != 1 {
+			t.Errorf("with %d options: CTRL+A fired %d times while held, want 1", len(opts), got)
+		}
+	}
+
+	m := NewManager()
+	ticks := make(chan struct{}, 100)
+	spec := BindingSpec{Combo: "CTRL+A", Callback: func() { ticks <- struct{}{} }, TickInterval: time.Millisecond}
+	if _, err := m.RegisterSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"))
+	waitFired(t, ticks, 3)
+	m.HandleEvent(release("KEY_A"))
+	waitTimers(t, m)
+}
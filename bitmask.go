@@ -0,0 +1,39 @@
+package keyboard
+
+import "sync/atomic"
+
+// maxBitmaskKey is the number of key codes tracked by keyBitmask, which
+// covers every key of a standard HID keyboard.
+const maxBitmaskKey = 256
+
+// keyBitmask records which keys with codes below maxBitmaskKey are pressed.
+// It is safe for concurrent use without additional locking.
+type keyBitmask [maxBitmaskKey / 64]atomic.Uint64
+
+// bitmaskCode returns the evdev code of key and whether it fits in a keyBitmask.
+func bitmaskCode(key string) (uint16, bool) {
+	code, ok := keyCodes[key]
+	return code, ok && code < maxBitmaskKey
+}
+
+// set marks code as pressed.
+func (b *keyBitmask) set(code uint16) {
+	b[code/64].Or(1 << (code % 64))
+}
+
+// clear marks code as released.
+func (b *keyBitmask) clear(code uint16) {
+	b[code/64].And(^(uint64(1) << (code % 64)))
+}
+
+// has reports whether code is pressed.
+func (b *keyBitmask) has(code uint16) bool {
+	return b[code/64].Load()&(1<<(code%64)) != 0
+}
+
+// reset marks all keys as released.
+func (b *keyBitmask) reset() {
+	for i := range b {
+		b[i].Store(0)
+	}
+}
@@ -0,0 +1,53 @@
+package keyboard
+
+import "testing"
+
+func TestKeyBitmask(t *testing.T) {
+	var b keyBitmask
+	codes := []uint16{0, 1, 63, 64, 127, 200, maxBitmaskKey - 1}
+	for _, c := range codes {
+		b.set(c)
+	}
+	for c := range uint16(maxBitmaskKey) {
+		want := false
+		for _, set := range codes {
+			want = want || c == set
+		}
+		if b.has(c) != want {
+			t.Errorf("has(%d) = %v, want %v", c, !want, want)
+		}
+	}
+	b.clear(64)
+	if b.has(64) || !b.has(63) {
+		t.Errorf("clear(64) cleared the wrong bit")
+	}
+	b.reset()
+	for _, c := range codes {
+		if b.has(c) {
+			t.Errorf("has(%d) after reset", c)
+		}
+	}
+}
+
+func TestIsPressed(t *testing.T) {
+	m := NewManager()
+	if _, ok := bitmaskCode("BTN_LEFT"); ok {
+		t.Fatal("BTN_LEFT fits in the bitmask")
+	}
+	m.HandleEvent(press("KEY_A"), press("BTN_LEFT"), press("KEY_LEFTCTRL"))
+	for _, key := range []string{"KEY_A", "BTN_LEFT", "KEY_LEFTCTRL"} {
+		if !m.IsPressed(key) {
+			t.Errorf("IsPressed(%s) = false after Press", key)
+		}
+	}
+	m.HandleEvent(release("KEY_A"), release("BTN_LEFT"))
+	for _, key := range []string{"KEY_A", "BTN_LEFT", "KEY_B"} {
+		if m.IsPressed(key) {
+			t.Errorf("IsPressed(%s) = true after Release", key)
+		}
+	}
+	m.HandleEvent(Event{Type: Disconnect})
+	if m.IsPressed("KEY_LEFTCTRL") {
+		t.Errorf("IsPressed(KEY_LEFTCTRL) = true after Disconnect")
+	}
+}
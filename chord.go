@@ -0,0 +1,25 @@
+package keyboard
+
+import "time"
+
+// SetChordWindow lets the keys of a combo be pressed in any order, as long
+// as all of them are pressed within d of the first: pressing A and then
+// CTRL within d fires "CTRL+A", like a chord on keyboard firmware. A
+// modifier pressed later than d after the first key of the chord completes
+// nothing, as without a chord window, and once d has passed the partial
+// chord is discarded: the next key pressed starts a new chord, so keys held
+// since before it do not combine with modifiers pressed later. Combos still
+// fire as usual when their trigger is pressed last, so the bindings for A
+// fire too. Zero, the default, requires modifiers to be held before the
+// trigger is pressed.
+func (m *Manager) SetChordWindow(d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.chordWindow = d
+}
+
+// inChordWindow reports whether the current chord started less than the
+// chord window ago. The caller must hold m.mu.
+func (m *Manager) inChordWindow() bool {
+	return m.chordWindow > 0 && time.Since(m.chordStart) <= m.chordWindow
+}
@@ -0,0 +1,37 @@
+package keyboard
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChordWindow(t *testing.T) {
+	const window = 30 * time.Millisecond
+	var pause Event // waits for the chord window to expire
+	for _, tc := range []struct {
+		name   string
+		events []Event
+		want   int
+	}{
+		{"modifier within window", []Event{press("KEY_A"), press("KEY_LEFTCTRL")}, 1},
+		{"modifier after window", []Event{press("KEY_A"), pause, press("KEY_LEFTCTRL")}, 0},
+		{"trigger pressed last", []Event{press("KEY_LEFTCTRL"), pause, press("KEY_A")}, 1},
+		{"new chord after window", []Event{press("KEY_B"), pause, press("KEY_A"), press("KEY_LEFTCTRL")}, 1},
+		{"key from expired chord", []Event{press("KEY_A"), pause, press("KEY_B"), press("KEY_LEFTCTRL")}, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, fired := newCountingManager(t, []string{"CTRL+A"})
+			m.SetChordWindow(window)
+			for _, ev := range tc.events {
+				if ev == pause {
+					time.Sleep(2 * window)
+					continue
+				}
+				m.HandleEvent(ev)
+			}
+			if fired["CTRL+A"] != tc.want {
+				t.Errorf("CTRL+A fired %d times, want %d", fired["CTRL+A"], tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package keyboard
+
+import (
+	"fmt"
+	"testing"
+)
+
+// The combos built from pressed keys are looked up among the combos
+// normalized by ParseCombo, so every combo joinCombo builds must already be
+// normalized: parsing it again yields the same string. init installs this
+// invariant for all of the package's tests.
+func init() {
+	checkCombo = func(combo string) {
+		// modifier names added by WithModifierAliases are not key names
+		isMod := func(p string) bool { return isModifierName(p) || keyCode(p) == "" }
+		if norm, err := parseCombo(combo, isMod); err != nil || norm != combo {
+			panic(fmt.Sprintf("joinCombo built %q, which normalizes to %q, %v", combo, norm, err))
+		}
+	}
+}
+
+func TestPressedComboMatchesParseCombo(t *testing.T) {
+	// bindings are stored under the ParseCombo result and found under the
+	// combo built from the pressed keys, so a binding fires only if the two
+	// are identical
+	for _, tc := range []struct {
+		name  string
+		opts  []ManagerOption
+		combo string
+		evs   []Event
+		want  int
+	}{
+		{"left modifiers", nil, "ctrl+shift+a", []Event{press("KEY_LEFTSHIFT"), press("KEY_LEFTCTRL"), press("KEY_A")}, 1},
+		{"right modifiers", nil, "Shift+Ctrl+A", []Event{press("KEY_RIGHTCTRL"), press("KEY_RIGHTSHIFT"), press("KEY_A")}, 1},
+		{"left and right held", nil, "CTRL+A", []Event{press("KEY_LEFTCTRL"), press("KEY_RIGHTCTRL"), press("KEY_A")}, 1},
+		{"altgr", nil, "alt+meta+x", []Event{press("KEY_LEFTMETA"), press("KEY_RIGHTALT"), press("KEY_X")}, 1},
+		{"repeated press", nil, "CTRL+A", []Event{press("KEY_LEFTCTRL"), press("KEY_A"), press("KEY_A")}, 2},
+		{"repeated press suppressed", []ManagerOption{WithSuppressRepeats()}, "CTRL+A", []Event{press("KEY_LEFTCTRL"), press("KEY_A"), press("KEY_A")}, 1},
+		{"repeated modifier", []ManagerOption{WithSuppressRepeats()}, "CTRL+A", []Event{press("KEY_LEFTCTRL"), press("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), press("KEY_A")}, 1},
+		{"prefix key", nil, "a+b", []Event{press("KEY_A"), press("KEY_B")}, 1},
+		{"modifier and prefix key", nil, "shift+key_a+b", []Event{press("KEY_LEFTSHIFT"), press("KEY_A"), press("KEY_B")}, 1},
+		{"function key", nil, "meta+f12", []Event{press("KEY_LEFTMETA"), press("KEY_F12")}, 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			m, fired := newCountingManager(t, []string{tc.combo}, tc.opts...)
+			m.HandleEvent(tc.evs...)
+			if fired[tc.combo] != tc.want {
+				norm, _ := ParseCombo(tc.combo)
+				t.Errorf("%s (%s) fired %d times after %v, want %d", tc.combo, norm, fired[tc.combo], tc.evs, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckComboRejectsUnnormalized(t *testing.T) {
+	for _, combo := range []string{"SHIFT+CTRL+A", "CTRL+CTRL+A", "KEY_A", "ctrl+a", "A+SHIFT"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("checkCombo(%q) accepted an unnormalized combo", combo)
+				}
+			}()
+			checkCombo(combo)
+		}()
+	}
+}
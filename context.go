@@ -0,0 +1,49 @@
+package keyboard
+
+import (
+	"context"
+	"time"
+)
+
+// SetCallbackTimeout makes the Manager log a warning for every callback
+// that is still running after d. Callbacks are not interrupted, but those
+// registered with RegisterBindingCtx receive a context with a deadline d
+// after they start. Zero disables the timeout.
+func (m *Manager) SetCallbackTimeout(d time.Duration) {
+	m.callbackTimeout.Store(int64(d))
+}
+
+// CallbackTimeout returns the timeout set with SetCallbackTimeout.
+func (m *Manager) CallbackTimeout() time.Duration {
+	return time.Duration(m.callbackTimeout.Load())
+}
+
+// RegisterBindingCtx registers cb for combo like RegisterBinding, passing
+// it a context that is done once the callback timeout elapses or cb
+// returns, so that callbacks doing I/O can stop in time. Without a callback
+// timeout the context has no deadline. It returns 0 and logs a warning if
+// combo is invalid.
+func (m *Manager) RegisterBindingCtx(combo string, cb func(ctx context.Context)) BindingID {
+	var fn BindingCallback
+	if cb != nil {
+		fn = func() {
+			var (
+				ctx    context.Context
+				cancel context.CancelFunc
+			)
+			if timeout := m.CallbackTimeout(); timeout > 0 {
+				ctx, cancel = context.WithTimeout(context.Background(), timeout)
+			} else {
+				ctx, cancel = context.WithCancel(context.Background())
+			}
+			defer cancel()
+			cb(ctx)
+		}
+	}
+	id, err := m.RegisterSpec(BindingSpec{Combo: combo, Callback: fn})
+	if err != nil {
+		m.logger.Warn("ignoring invalid binding", "combo", combo, "error", err)
+		return 0
+	}
+	return id
+}
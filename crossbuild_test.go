@@ -0,0 +1,29 @@
+package keyboard
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+)
+
+// TestCrossBuild checks that the module, including its tests, builds on
+// platforms without evdev, where only the portable parts of the package and
+// StubBackend are available.
+func TestCrossBuild(t *testing.T) {
+	if testing.Short() {
+		t.Skip("cross-building is slow")
+	}
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	for _, goos := range []string{"windows", "darwin"} {
+		t.Run(goos, func(t *testing.T) {
+			cmd := exec.Command(gotool, "vet", "./...")
+			cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH=amd64", "CGO_ENABLED=0")
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Errorf("GOOS=%s go vet ./...: %v\n%s", goos, err, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// NewManagerForDevice creates a Manager that listens to the input device at
+// path and handles its key events until Close is called.
+func NewManagerForDevice(path string, opts ...ManagerOption) (*Manager, error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	leds, err := readLEDs(dev)
+	if err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: reading LED state: %w", ErrDeviceRead, path, err)
+	}
+	keys, err := readKeys(dev)
+	if err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: reading key state: %w", ErrDeviceRead, path, err)
+	}
+	// allows Close to interrupt the blocking read in run; must come after
+	// all ioctls, which switch the device back to blocking mode
+	if err := dev.NonBlock(); err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: setting non-blocking mode: %w", ErrDeviceOpen, path, err)
+	}
+	m := NewManager(opts...)
+	m.leds = leds
+	m.syncKeys(keys)
+	m.device = dev
+	m.devicePath = path
+	go m.run(dev)
+	return m, nil
+}
+
+// SyncKeyState reads which keys are currently held on the device at path
+// and marks them as pressed, so that combos involving keys held down before
+// the Manager started, such as CTRL, match. Keys pressed this way fire no
+// callbacks. NewManagerForDevice does this automatically.
+func (m *Manager) SyncKeyState(path string) error {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer dev.Close()
+	keys, err := readKeys(dev)
+	if err != nil {
+		return fmt.Errorf("%w %s: reading key state: %w", ErrDeviceRead, path, err)
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.syncKeys(keys)
+	return nil
+}
+
+// readKeys returns the code names of the keys currently held on dev.
+func readKeys(dev *evdev.InputDevice) ([]string, error) {
+	st, err := dev.State(evdev.EV_KEY)
+	if err != nil {
+		return nil, err
+	}
+	var keys []string
+	for code, down := range st {
+		if down {
+			keys = append(keys, internKey(keyCodeName(code)))
+		}
+	}
+	return keys, nil
+}
+
+// readLEDs returns the current LED state of dev.
+func readLEDs(dev *evdev.InputDevice) (ledState, error) {
+	var l ledState
+	st, err := dev.State(evdev.EV_LED)
+	if err != nil {
+		return l, err
+	}
+	for code, on := range st {
+		l.set(code, on)
+	}
+	return l, nil
+}
+
+// set updates the LED with the given evdev code, ignoring other LEDs.
+func (l *ledState) set(code evdev.EvCode, on bool) {
+	switch code {
+	case evdev.LED_CAPSL:
+		l.caps = on
+	case evdev.LED_NUML:
+		l.num = on
+	case evdev.LED_SCROLLL:
+		l.scroll = on
+	}
+}
+
+// handleLED records a raw EV_LED event.
+func (m *Manager) handleLED(ev *evdev.InputEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.leds.set(ev.Code, ev.Value != 0)
+}
+
+// syncKeys marks keys as pressed without firing any bindings. The caller
+// must hold m.mu.
+func (m *Manager) syncKeys(keys []string) {
+	for _, key := range keys {
+		m.keys.UpdateKey(key, Press)
+		if code, ok := bitmaskCode(key); ok {
+			m.pressedBits.set(code)
+		}
+		m.updateModifierBits(key, true)
+	}
+}
+
+// run feeds events read from dev to HandleEvent until reading fails.
+func (m *Manager) run(dev *evdev.InputDevice) {
+	for {
+		ev, err := dev.ReadOne()
+		if err != nil {
+			m.HandleEvent(Event{Type: Disconnect})
+			return
+		}
+		if ev.Type == evdev.EV_LED {
+			m.handleLED(ev)
+			continue
+		}
+		if kev, ok := ConvertEvent(ev); ok {
+			m.HandleEvent(kev)
+		}
+	}
+}
+
+// devicePathOrErr returns the path of the device the Manager listens to,
+// or ErrManagerClosed once it has been closed.
+func (m *Manager) devicePathOrErr() (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.devicePath == "" {
+		return "", ErrNotListening
+	}
+	if m.device == nil {
+		return "", ErrManagerClosed
+	}
+	return m.devicePath, nil
+}
+
+// Device is an input device opened for both reading and injecting events.
+type Device struct {
+	dev      *evdev.InputDevice
+	path     string
+	writable bool // opened for writing, so InjectKey can succeed
+}
+
+// Open opens the input device at path. It is opened for reading and
+// writing if permitted, or else for reading only, in which case InjectKey
+// fails.
+func Open(path string) (*Device, error) {
+	writable := true
+	dev, err := evdev.OpenWithFlags(path, os.O_RDWR)
+	if err != nil {
+		writable = false
+		dev, err = evdev.Open(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	// allows Close to interrupt the blocking read in Listen
+	if err := dev.NonBlock(); err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: setting non-blocking mode: %w", ErrDeviceOpen, path, err)
+	}
+	return &Device{dev: dev, path: path, writable: writable}, nil
+}
+
+// Listen starts reading key events from d and returns a channel streaming
+// them. Like the package-level Listen, it sends a Disconnect event and
+// closes the channel when reading fails. Since a pending read can only be
+// interrupted by closing the device, d is closed once ctx is done.
+func (d *Device) Listen(ctx context.Context) <-chan Event {
+	out := make(chan Event)
+	stop := context.AfterFunc(ctx, func() { d.Close() })
+	go func() {
+		defer close(out)
+		defer stop()
+		for {
+			ev, err := d.dev.ReadOne()
+			if err != nil {
+				select {
+				case out <- Event{Type: Disconnect}:
+				case <-ctx.Done():
+				}
+				return
+			}
+			kev, ok := ConvertEvent(ev)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- kev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// InjectKey writes a synthetic event of type et for key (e.g., "KEY_A" or
+// "A") to d, followed by a SYN_REPORT. Readers of the device, including
+// d's own Listen, receive it like a real key event.
+func (d *Device) InjectKey(key string, et EventType) error {
+	code, ok := evdev.KEYFromString[keyCode(key)]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrKeyNameInvalid, key)
+	}
+	var value int32
+	switch et {
+	case Release:
+		value = 0
+	case Press:
+		value = 1
+	case Hold:
+		value = 2
+	default:
+		return fmt.Errorf("%w: cannot inject %v event", ErrInvalidArgument, et)
+	}
+	if !d.writable {
+		return fmt.Errorf("%w %s: not open for writing", ErrDeviceWrite, d.path)
+	}
+	for _, ev := range []evdev.InputEvent{
+		{Type: evdev.EV_KEY, Code: code, Value: value},
+		{Type: evdev.EV_SYN, Code: evdev.SYN_REPORT},
+	} {
+		if err := d.dev.WriteOne(&ev); err != nil {
+			return fmt.Errorf("%w %s: %w", ErrDeviceWrite, d.path, err)
+		}
+	}
+	return nil
+}
+
+// Close closes d, ending its Listen stream.
+func (d *Device) Close() error {
+	return d.dev.Close()
+}
@@ -0,0 +1,78 @@
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+)
+
+// Sentinel errors wrapped by the errors returned from this package, for use
+// with errors.Is.
+var (
+	// ErrNoKeyboardFound is returned when no keyboard device is detected.
+	ErrNoKeyboardFound = errors.New("no keyboard found")
+	// ErrNoKeyboardFoundWithinTimeout is returned by ListenWait when no
+	// keyboard appeared before the timeout set with WithStartupTimeout.
+	ErrNoKeyboardFoundWithinTimeout = errors.New("no keyboard found within timeout")
+	// ErrDeviceOpen is returned when an input device cannot be opened.
+	ErrDeviceOpen = errors.New("cannot open device")
+	// ErrDevicePermission is returned, along with ErrDeviceOpen, when an
+	// input device cannot be opened for lack of permission.
+	ErrDevicePermission = errors.New("permission denied")
+	// ErrDeviceRead is returned when the state of an input device cannot
+	// be read.
+	ErrDeviceRead = errors.New("cannot read device")
+	// ErrKeyNameInvalid is returned for unknown key names and malformed
+	// combos.
+	ErrKeyNameInvalid = errors.New("invalid key name")
+	// ErrBindingNotFound is returned when no binding matches the one to
+	// operate on.
+	ErrBindingNotFound = errors.New("binding not found")
+	// ErrManagerClosed is returned for device operations on a Manager
+	// after Close.
+	ErrManagerClosed = errors.New("manager is closed")
+	// ErrNotSupported is returned by StubBackend on platforms without
+	// evdev.
+	ErrNotSupported = errors.New("not supported on this platform")
+	// ErrTooManyPressedKeys is returned by HealthCheck when more keys are
+	// held than a keyboard plausibly reports, which indicates lost Release
+	// events.
+	ErrTooManyPressedKeys = errors.New("too many pressed keys")
+	// ErrCallbackQueueFull is returned by HealthCheck when too many
+	// callbacks are running at once, which indicates callbacks that block.
+	ErrCallbackQueueFull = errors.New("too many running callbacks")
+	// ErrDuplicateBinding is reported by ValidateBindings for combos with
+	// more than one binding.
+	ErrDuplicateBinding = errors.New("combo has more than one binding")
+	// ErrTooManyModifiers is reported by ValidateBindings for combos with
+	// more than MaxComboModifiers modifiers.
+	ErrTooManyModifiers = errors.New("combo has too many modifiers")
+	// ErrShadowedBinding is reported by ValidateBindings for bindings that
+	// can never fire because a wildcard binding for the same trigger takes
+	// precedence.
+	ErrShadowedBinding = errors.New("binding is shadowed")
+	// ErrLayoutUnknown is returned by DetectLayout when no keyboard layout
+	// is configured.
+	ErrLayoutUnknown = errors.New("keyboard layout unknown")
+	// ErrNilCallback is returned when registering a binding without a
+	// callback.
+	ErrNilCallback = errors.New("binding has no callback")
+	// ErrNotListening is returned for device operations on a Manager not
+	// created by NewManagerForDevice.
+	ErrNotListening = errors.New("manager is not listening to a device")
+	// ErrDeviceWrite is returned when events or settings cannot be written
+	// to an input device.
+	ErrDeviceWrite = errors.New("cannot write device")
+	// ErrInvalidArgument is returned for out-of-range durations, invalid
+	// patterns and events that cannot be injected.
+	ErrInvalidArgument = errors.New("invalid argument")
+)
+
+// openError returns the error for failing to open the device at path with
+// err, wrapping ErrDevicePermission with a hint if access was denied.
+func openError(path string, err error) error {
+	if errors.Is(err, fs.ErrPermission) {
+		return fmt.Errorf("%w %s: %w (add the user to the \"input\" group or set a udev rule): %w", ErrDeviceOpen, path, ErrDevicePermission, err)
+	}
+	return fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+}
@@ -0,0 +1,37 @@
+package keyboard
+
+import (
+	"errors"
+	"testing"
+)
+
+// idErr returns the error of a registration returning a BindingID.
+func idErr(_ BindingID, err error) error { return err }
+
+func TestSentinelErrors(t *testing.T) {
+	m := NewManager()
+	nop := func() {}
+	for _, tc := range []struct {
+		name string
+		err  func() error
+		want error
+	}{
+		{"invalid combo", func() error { return idErr(m.RegisterSpec(BindingSpec{Combo: "CTRL+NOPE", Callback: nop})) }, ErrKeyNameInvalid},
+		{"nil callback", func() error { return idErr(m.RegisterSpec(BindingSpec{Combo: "CTRL+A"})) }, ErrNilCallback},
+		{"unknown axis", func() error { return idErr(m.RegisterAxisBinding("ABS_NOPE", 0, Rising, nop)) }, ErrKeyNameInvalid},
+		{"nil axis callback", func() error { return idErr(m.RegisterAxisBinding("ABS_X", 0, Rising, nil)) }, ErrNilCallback},
+		{"hold ticker interval", func() error { return idErr(m.RegisterHoldTicker("A", 0, nop)) }, ErrInvalidArgument},
+		{"unregister unknown combo", func() error { return m.UnregisterCombo("CTRL+Z") }, ErrBindingNotFound},
+		{"fire unknown combo", func() error { return m.FireCombo("CTRL+Z") }, ErrBindingNotFound},
+		{"restore without callback", func() error {
+			return m.Restore(ManagerSnapshot{Bindings: []BindingSpec{{Combo: "CTRL+A"}}})
+		}, ErrBindingNotFound},
+		{"event without key", func() error { return m.HandleEvent(Event{Type: Press}) }, ErrKeyNameInvalid},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.err(); !errors.Is(err, tc.want) {
+				t.Errorf("error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package keyboard
+
+// EventPredicate reports whether an event is of interest. Predicates are
+// built with IsPress, IsKey and friends and combined with And, Or and Not.
+type EventPredicate func(Event) bool
+
+// And returns a predicate matching events matched by both a and b.
+func And(a, b EventPredicate) EventPredicate {
+	return func(ev Event) bool { return a(ev) && b(ev) }
+}
+
+// Or returns a predicate matching events matched by a or b.
+func Or(a, b EventPredicate) EventPredicate {
+	return func(ev Event) bool { return a(ev) || b(ev) }
+}
+
+// Not returns a predicate matching events not matched by p.
+func Not(p EventPredicate) EventPredicate {
+	return func(ev Event) bool { return !p(ev) }
+}
+
+// IsPress returns a predicate matching Press events.
+func IsPress() EventPredicate {
+	return func(ev Event) bool { return ev.Type == Press }
+}
+
+// IsRelease returns a predicate matching Release events.
+func IsRelease() EventPredicate {
+	return func(ev Event) bool { return ev.Type == Release }
+}
+
+// IsHold returns a predicate matching Hold events.
+func IsHold() EventPredicate {
+	return func(ev Event) bool { return ev.Type == Hold }
+}
+
+// IsKey returns a predicate matching events for key, which may be given in
+// short form (e.g., "A" for "KEY_A").
+func IsKey(key string) EventPredicate {
+	code := keyCode(key)
+	return func(ev Event) bool { return code != "" && ev.Key == code }
+}
+
+// IsModifier returns a predicate matching events for the built-in modifier
+// keys (CTRL, SHIFT, ALT and META on either side).
+func IsModifier() EventPredicate {
+	return func(ev Event) bool { return isModifier(ev.Key) }
+}
+
+// FilterEvents returns a channel receiving the events from in that match p.
+// It is closed once in is closed.
+func FilterEvents(in <-chan Event, p EventPredicate) <-chan Event {
+	out := make(chan Event)
+	go func() {
+		defer close(out)
+		for ev := range in {
+			if p(ev) {
+				out <- ev
+			}
+		}
+	}()
+	return out
+}
+
+// AddFilter makes the Manager ignore key events not matched by p. Events
+// must match every added filter to be handled; Disconnect events are always
+// handled so that the pressed key state is reset.
+func (m *Manager) AddFilter(p EventPredicate) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.filters = append(m.filters, p)
+}
+
+// filtered reports whether ev is rejected by one of the added filters. The
+// caller must hold m.mu.
+func (m *Manager) filtered(ev Event) bool {
+	if ev.Type == Disconnect {
+		return false
+	}
+	for _, p := range m.filters {
+		if !p(ev) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package keyboard
+
+import "testing"
+
+func FuzzParseCombo(f *testing.F) {
+	for _, s := range []string{"CTRL+A", "ctrl + shift + t", "*+A", "A+B", "KEY_LEFTCTRL+A", "CTRL+", "+", "", "CTRL+CTRL+A", "*+*", "RAW_500", "A+A", "\x00+\xff"} {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		norm, err := ParseCombo(string(data))
+		if (norm == "") == (err == nil) {
+			t.Fatalf("ParseCombo(%q) = %q, %v: want a combo or an error", data, norm, err)
+		}
+	})
+}
+
+func FuzzHandleEvent(f *testing.F) {
+	f.Add("KEY_A", int(Press), "KEY_LEFTCTRL", int(Release))
+	f.Add("", int(Disconnect), "KEY_A", int(Hold))
+	f.Add("RAW_500", 42, "REL_WHEEL_UP", -1)
+	f.Fuzz(func(t *testing.T, key1 string, type1 int, key2 string, type2 int) {
+		m, _ := newCountingManager(t, []string{"CTRL+A", "*+A", "A+B", "SHIFT+KEY_B"})
+		m.RegisterKeyCallback("KEY_A", func() {}, func() {})
+		evs := []Event{
+			{Key: key1, Type: EventType(type1)},
+			press("KEY_LEFTCTRL"),
+			{Key: key2, Type: EventType(type2)},
+			press("KEY_A"),
+			{Key: key1, Type: EventType(type2)},
+			release("KEY_A"),
+			{Key: key2, Type: EventType(type1)},
+		}
+		for _, ev := range evs {
+			m.HandleEvent(ev)
+		}
+		m.CurrentlyPressed()
+		m.InferCombo()
+	})
+}
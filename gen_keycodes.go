@@ -0,0 +1,112 @@
+//go:build ignore
+
+// gen_keycodes generates keycodes.go, which declares a string constant for
+// every evdev EV_KEY code name known to go-evdev, along with tables of the
+// key codes and axis names that do not depend on go-evdev, which only
+// builds on Linux. Run it with go generate.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/holoplot/go-evdev"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// skipped lists names in evdev.KEYFromString that are bounds, not keys.
+var skipped = map[string]bool{"KEY_MAX": true, "KEY_CNT": true}
+
+// constName returns the Go constant name for an evdev key code name, e.g.
+// "KeyCodeLeftCtrl" for "KEY_LEFTCTRL" and "KeyCodeBtnLeft" for "BTN_LEFT".
+func constName(code string) string {
+	code = strings.TrimPrefix(code, "KEY_")
+	var b strings.Builder
+	b.WriteString("KeyCode")
+	for _, part := range strings.Split(code, "_") {
+		for _, prefix := range []string{"LEFT", "RIGHT", "KP"} {
+			if rest, ok := strings.CutPrefix(part, prefix); ok && rest != "" {
+				b.WriteString(title(prefix))
+				part = rest
+				break
+			}
+		}
+		b.WriteString(title(part))
+	}
+	return b.String()
+}
+
+// title returns s with its first letter in upper case and the rest in lower
+// case.
+func title(s string) string {
+	if s == "" {
+		return s
+	}
+	return s[:1] + strings.ToLower(s[1:])
+}
+
+func main() {
+	codes := maps.Keys(evdev.KEYFromString)
+	slices.SortFunc(codes, func(a, b string) int {
+		if ca, cb := evdev.KEYFromString[a], evdev.KEYFromString[b]; ca != cb {
+			return int(ca) - int(cb)
+		}
+		return strings.Compare(a, b)
+	})
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_keycodes.go; DO NOT EDIT.\n\n")
+	buf.WriteString("package keyboard\n\n")
+	buf.WriteString("// Names of the evdev key codes, for use in combos and events (e.g.,\n")
+	buf.WriteString("// KeyCodeLeftCtrl is \"KEY_LEFTCTRL\"). Names sharing a code are aliases.\n")
+	buf.WriteString("const (\n")
+	seen := make(map[string]string)
+	for _, code := range codes {
+		if skipped[code] {
+			continue
+		}
+		name := constName(code)
+		if prev, dup := seen[name]; dup {
+			log.Fatalf("%s and %s both map to %s", prev, code, name)
+		}
+		seen[name] = code
+		fmt.Fprintf(&buf, "\t%s = %q\n", name, code)
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// keyCodes maps the evdev key code names to their codes.\n")
+	buf.WriteString("var keyCodes = map[string]uint16{\n")
+	for _, code := range codes {
+		if !skipped[code] {
+			fmt.Fprintf(&buf, "\t%q: %d,\n", code, evdev.KEYFromString[code])
+		}
+	}
+	buf.WriteString("}\n\n")
+
+	axes := maps.Keys(evdev.ABSFromString)
+	slices.SortFunc(axes, func(a, b string) int {
+		if ca, cb := evdev.ABSFromString[a], evdev.ABSFromString[b]; ca != cb {
+			return int(ca) - int(cb)
+		}
+		return strings.Compare(a, b)
+	})
+	buf.WriteString("// axisNames is the set of evdev absolute axis code names.\n")
+	buf.WriteString("var axisNames = map[string]bool{\n")
+	for _, axis := range axes {
+		fmt.Fprintf(&buf, "\t%q: true,\n", axis)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("keycodes.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -5,4 +5,5 @@ go 1.24.2
 require (
 	github.com/holoplot/go-evdev v0.0.0-20240306072622-217e18f17db1
 	golang.org/x/exp v0.0.0-20250506013437-ce4c2cf36ca6
+	golang.org/x/sys v0.33.0
 )
@@ -0,0 +1,119 @@
+//go:build linux
+
+// Package grab provides exclusive access to a keyboard that is released
+// when the process receives a termination signal, so a hotkey daemon never
+// leaves the keyboard grabbed and unusable for the rest of the system.
+package grab
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+	"github.com/holoplot/go-evdev"
+	"golang.org/x/sys/unix"
+)
+
+// releaseSignals are the signals on which the grab is released.
+var releaseSignals = []os.Signal{unix.SIGINT, unix.SIGTERM, unix.SIGHUP}
+
+// GrabbedKeyboard is a keyboard grabbed for exclusive access with
+// GrabKeyboard.
+type GrabbedKeyboard struct {
+	dev    *evdev.InputDevice
+	events chan keyboard.Event
+	sigs   chan os.Signal
+	done   chan struct{} // closed on Release
+	stop   func() bool   // unregisters the context callback
+
+	once sync.Once
+	err  error
+}
+
+// GrabKeyboard grabs the keyboard at path with EVIOCGRAB, so that its events
+// are delivered only to the returned GrabbedKeyboard. The grab is released
+// when Release is called, when ctx is done, or when the process receives
+// SIGINT, SIGTERM or SIGHUP; in the latter case the signal is then raised
+// again so that its default action, usually terminating the process, still
+// takes place.
+func GrabKeyboard(ctx context.Context, path string) (*GrabbedKeyboard, error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", keyboard.ErrDeviceOpen, path, err)
+	}
+	if err := dev.Grab(); err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: grabbing: %w", keyboard.ErrDeviceOpen, path, err)
+	}
+	// allows Release to interrupt the blocking read; must come after all
+	// ioctls, which switch the device back to blocking mode
+	if err := dev.NonBlock(); err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: setting non-blocking mode: %w", keyboard.ErrDeviceOpen, path, err)
+	}
+	g := &GrabbedKeyboard{
+		dev:    dev,
+		events: make(chan keyboard.Event),
+		sigs:   make(chan os.Signal, 1),
+		done:   make(chan struct{}),
+	}
+	signal.Notify(g.sigs, releaseSignals...)
+	g.stop = context.AfterFunc(ctx, func() { g.Release() })
+	go g.watchSignals()
+	go g.read()
+	return g, nil
+}
+
+// Events returns the channel streaming the keyboard's key events. It is
+// closed once the grab is released.
+func (g *GrabbedKeyboard) Events() <-chan keyboard.Event {
+	return g.events
+}
+
+// Release releases the grab by closing the device. Subsequent calls return
+// the same error.
+func (g *GrabbedKeyboard) Release() error {
+	g.once.Do(func() {
+		signal.Stop(g.sigs)
+		close(g.sigs)
+		g.stop()
+		close(g.done)
+		// the kernel releases the grab when the device is closed
+		g.err = g.dev.Close()
+	})
+	return g.err
+}
+
+// watchSignals releases the grab on the first release signal and raises it
+// again.
+func (g *GrabbedKeyboard) watchSignals() {
+	sig, ok := <-g.sigs
+	if !ok {
+		return
+	}
+	g.Release()
+	if s, ok := sig.(unix.Signal); ok {
+		unix.Kill(os.Getpid(), s)
+	}
+}
+
+// read delivers the key events of the device until reading fails.
+func (g *GrabbedKeyboard) read() {
+	defer close(g.events)
+	for {
+		ev, err := g.dev.ReadOne()
+		if err != nil {
+			return
+		}
+		if kev, ok := keyboard.ConvertEvent(ev); ok {
+			select {
+			case g.events <- kev:
+			case <-g.done:
+				return
+			}
+		}
+	}
+}
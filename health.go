@@ -0,0 +1,30 @@
+package keyboard
+
+import "fmt"
+
+// Thresholds above which HealthCheck reports the Manager as degraded.
+const (
+	MaxHealthyPressedKeys      = 20
+	MaxHealthyRunningCallbacks = 1000
+)
+
+// HealthCheck returns nil if the Manager is operating normally. It returns
+// ErrManagerClosed after Close, ErrTooManyPressedKeys if more than
+// MaxHealthyPressedKeys keys are held, and ErrCallbackQueueFull if more than
+// MaxHealthyRunningCallbacks callbacks are running. It is meant for process
+// supervisors and readiness probes.
+func (m *Manager) HealthCheck() error {
+	m.mu.RLock()
+	closed, pressed := m.closed, m.keys.count()
+	m.mu.RUnlock()
+	if closed {
+		return ErrManagerClosed
+	}
+	if pressed > MaxHealthyPressedKeys {
+		return fmt.Errorf("%w: %d keys held", ErrTooManyPressedKeys, pressed)
+	}
+	if n := m.running.Load(); n > MaxHealthyRunningCallbacks {
+		return fmt.Errorf("%w: %d running", ErrCallbackQueueFull, n)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package keyboard
+
+// DefaultHistorySize is the number of events a Manager remembers for
+// EventHistory unless configured otherwise with WithHistorySize.
+const DefaultHistorySize = 64
+
+// eventRing is a fixed-size ring buffer of the most recent events.
+type eventRing struct {
+	buf  []Event
+	next int  // index of the slot written next
+	full bool // buf has wrapped around at least once
+}
+
+func newEventRing(size int) *eventRing {
+	if size < 0 {
+		size = 0
+	}
+	return &eventRing{buf: make([]Event, size)}
+}
+
+// push records ev, overwriting the oldest event once the buffer is full.
+func (r *eventRing) push(ev Event) {
+	if len(r.buf) == 0 {
+		return
+	}
+	r.buf[r.next] = ev
+	r.next++
+	if r.next == len(r.buf) {
+		r.next = 0
+		r.full = true
+	}
+}
+
+// len returns the number of events currently stored.
+func (r *eventRing) len() int {
+	if r.full {
+		return len(r.buf)
+	}
+	return r.next
+}
+
+// last returns up to n of the most recent events, most recent first.
+func (r *eventRing) last(n int) []Event {
+	if n > r.len() {
+		n = r.len()
+	}
+	if n <= 0 {
+		return nil
+	}
+	out := make([]Event, n)
+	i := r.next
+	for j := range out {
+		i--
+		if i < 0 {
+			i = len(r.buf) - 1
+		}
+		out[j] = r.buf[i]
+	}
+	return out
+}
+
+// WithHistorySize sets how many recent events the Manager keeps for
+// EventHistory. A size of zero disables the history.
+func WithHistorySize(n int) ManagerOption {
+	return func(m *Manager) {
+		m.history = newEventRing(n)
+	}
+}
+
+// EventHistory returns up to n of the most recent events processed by
+// HandleEvent, most recent first.
+func (m *Manager) EventHistory(n int) []Event {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.history.last(n)
+}
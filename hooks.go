@@ -0,0 +1,72 @@
+package keyboard
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// callbackHooks holds the hooks added with OnBeforeCallback and
+// OnAfterCallback. It has its own lock since Sync callbacks run while m.mu
+// is held.
+type callbackHooks struct {
+	mu         sync.RWMutex
+	before     []func(combo string, ev Event)
+	after      []func(combo string, d time.Duration)
+	unknown    []func(code uint16)
+	hasUnknown atomic.Bool // unknown is not empty
+}
+
+// get returns the registered hooks.
+func (h *callbackHooks) get() (before []func(string, Event), after []func(string, time.Duration)) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.before, h.after
+}
+
+// OnBeforeCallback adds a hook called before every callback with the combo
+// (or axis) the callback is bound to and the event that matched it. For
+// callbacks fired by HoldThreshold or TickInterval, ev is the Press event
+// that started the timer; for axis bindings it is the zero Event. Hooks
+// run in the order they were added, in the goroutine of the callback.
+func (m *Manager) OnBeforeCallback(hook func(combo string, ev Event)) {
+	m.hooks.mu.Lock()
+	defer m.hooks.mu.Unlock()
+	m.hooks.before = append(m.hooks.before, hook)
+}
+
+// OnAfterCallback adds a hook called after every callback with the combo
+// (or axis) the callback is bound to and how long the callback ran. Hooks
+// run in the order they were added, in the goroutine of the callback.
+func (m *Manager) OnAfterCallback(hook func(combo string, d time.Duration)) {
+	m.hooks.mu.Lock()
+	defer m.hooks.mu.Unlock()
+	m.hooks.after = append(m.hooks.after, hook)
+}
+
+// OnUnknownKey adds a hook called with the key code whenever a key evdev
+// has no name for is pressed, e.g. for logging unusual hardware. Such keys
+// are reported as "RAW_<code>" (e.g., "RAW_500") and can be bound like any
+// other key. Hooks run after the event has been handled, in the goroutine
+// calling HandleEvent.
+func (m *Manager) OnUnknownKey(hook func(code uint16)) {
+	m.hooks.mu.Lock()
+	defer m.hooks.mu.Unlock()
+	m.hooks.unknown = append(m.hooks.unknown, hook)
+	m.hooks.hasUnknown.Store(true)
+}
+
+// unknownKeys calls the OnUnknownKey hooks for each of codes.
+func (h *callbackHooks) unknownKeys(codes []uint16) {
+	if len(codes) == 0 {
+		return
+	}
+	h.mu.RLock()
+	hooks := h.unknown
+	h.mu.RUnlock()
+	for _, code := range codes {
+		for _, hook := range hooks {
+			hook(code)
+		}
+	}
+}
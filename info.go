@@ -0,0 +1,105 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"fmt"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// DeviceInfo describes an input device found by FindKeyboards.
+type DeviceInfo struct {
+	// Path is the device node (e.g., "/dev/input/event3").
+	Path string
+	// Name is the device name reported by the kernel.
+	Name string
+	// BusType, Vendor, Product and Version identify the hardware, as
+	// returned by DeviceVersion.
+	BusType, Vendor, Product, Version uint16
+	// DriverMajor, DriverMinor and DriverPatch are the evdev driver version,
+	// as returned by DriverVersion.
+	DriverMajor, DriverMinor, DriverPatch int
+}
+
+// FindKeyboards returns information about every input device supporting
+// key and repeat events, as keyboards do. Devices that cannot be opened,
+// e.g. for lack of permissions, are skipped. Use a KeyboardScanner for
+// other filters.
+func FindKeyboards() ([]DeviceInfo, error) {
+	return keyboardScanner().Scan()
+}
+
+// deviceInfo returns the DeviceInfo of dev, opened from path.
+func deviceInfo(path string, dev *evdev.InputDevice) (DeviceInfo, error) {
+	info := DeviceInfo{Path: path}
+	var err error
+	if info.Name, err = dev.Name(); err != nil {
+		return info, err
+	}
+	id, err := dev.InputID()
+	if err != nil {
+		return info, err
+	}
+	info.BusType, info.Vendor, info.Product, info.Version = id.BusType, id.Vendor, id.Product, id.Version
+	info.DriverMajor, info.DriverMinor, info.DriverPatch = dev.DriverVersion()
+	return info, nil
+}
+
+// DeviceVersion returns the bus type, vendor and product IDs and hardware
+// version of the input device at path, for applying workarounds for known
+// hardware.
+func DeviceVersion(path string) (busType, vendor, product, version uint16, err error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer dev.Close()
+	id, err := dev.InputID()
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("%w %s: reading ID: %w", ErrDeviceRead, path, err)
+	}
+	return id.BusType, id.Vendor, id.Product, id.Version, nil
+}
+
+// DriverVersion returns the version of the evdev driver serving the input
+// device at path, as reported by EVIOCGVERSION.
+func DriverVersion(path string) (major, minor, patch int, err error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return 0, 0, 0, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer dev.Close()
+	major, minor, patch = dev.DriverVersion()
+	return major, minor, patch, nil
+}
+
+// IsKeyboardPresent reports whether a keyboard Listen would use is
+// connected, without reading from it: devices are only opened briefly to
+// query their names and capabilities. It returns an error if the input
+// devices cannot be listed, or wrapping ErrDevicePermission if none of
+// them can be opened.
+func IsKeyboardPresent() (bool, error) {
+	infos, err := keyboardScanner().RequireName(defaultKeyboardName).Scan()
+	if err != nil {
+		return false, err
+	}
+	return len(infos) > 0, nil
+}
+
+// FindKeyboardByName returns the path of the first keyboard whose name
+// matches the regular expression pattern (e.g., "Logitech.*G Pro"). Listen
+// uses the pattern "(?i)keyboard". It returns an error wrapping
+// ErrNoKeyboardFound if no keyboard matches.
+func FindKeyboardByName(pattern string) (string, error) {
+	return keyboardScanner().RequireName(pattern).first()
+}
+
+// FindKeyboardByID returns the path of the first keyboard with the given
+// vendor and product IDs (e.g., the USB VID:PID). It returns
+// ErrNoKeyboardFound if no keyboard matches.
+func FindKeyboardByID(vendorID, productID uint16) (string, error) {
+	return keyboardScanner().Require(func(d ScannedDevice) bool {
+		return d.Vendor == vendorID && d.Product == productID
+	}).first()
+}
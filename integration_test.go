@@ -0,0 +1,145 @@
+//go:build integration && linux
+
+package keyboard
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// testKeyboardName is the name of the uinput keyboard created by
+// createKeyboard.
+const testKeyboardName = "go-evdev-keyboard test keyboard"
+
+// createKeyboard creates a uinput keyboard with the CTRL and A keys,
+// removed when the test ends, and returns it with the path of its event
+// device. It skips the test if /dev/uinput is not writable.
+func createKeyboard(t *testing.T) (*evdev.InputDevice, string) {
+	t.Helper()
+	f, err := os.OpenFile("/dev/uinput", os.O_WRONLY, 0)
+	if err != nil {
+		t.Skipf("/dev/uinput is not writable: %v", err)
+	}
+	f.Close()
+	dev, err := evdev.CreateDevice(testKeyboardName, evdev.InputID{BusType: 0x06, Vendor: 1, Product: 1, Version: 1},
+		map[evdev.EvType][]evdev.EvCode{evdev.EV_KEY: {evdev.KEY_LEFTCTRL, evdev.KEY_A}})
+	if err != nil {
+		t.Fatalf("creating uinput keyboard: %v", err)
+	}
+	t.Cleanup(func() { evdev.DestroyDevice(dev) })
+
+	// the event device appears asynchronously
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		paths, err := evdev.ListDevicePaths()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range paths {
+			if p.Name == testKeyboardName {
+				return dev, p.Path
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("event device of %q not found", testKeyboardName)
+	return nil, ""
+}
+
+// emit writes a key event with the given code and value to dev, followed by
+// a SYN_REPORT.
+func emit(t *testing.T, dev *evdev.InputDevice, code evdev.EvCode, value int32) {
+	t.Helper()
+	for _, ev := range []evdev.InputEvent{
+		{Type: evdev.EV_KEY, Code: code, Value: value},
+		{Type: evdev.EV_SYN, Code: evdev.SYN_REPORT},
+	} {
+		if err := dev.WriteOne(&ev); err != nil {
+			t.Fatalf("writing to uinput keyboard: %v", err)
+		}
+	}
+}
+
+// typeCtrlA emits pressing and releasing CTRL+A on dev.
+func typeCtrlA(t *testing.T, dev *evdev.InputDevice) {
+	t.Helper()
+	emit(t, dev, evdev.KEY_LEFTCTRL, 1)
+	emit(t, dev, evdev.KEY_A, 1)
+	emit(t, dev, evdev.KEY_A, 0)
+	emit(t, dev, evdev.KEY_LEFTCTRL, 0)
+}
+
+func TestListenRealDevice(t *testing.T) {
+	dev, path := createKeyboard(t)
+	h, err := listenPath(path, newListenConfig(nil))
+	if err != nil {
+		t.Fatalf("listening to %s: %v", path, err)
+	}
+	defer h.Close()
+
+	m, fired := newCountingManager(t, []string{"CTRL+A"})
+	typeCtrlA(t, dev)
+	want := []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL")}
+	for i, w := range want {
+		select {
+		case ev := <-h.Events:
+			if ev != w {
+				t.Fatalf("event %d = %v, want %v", i, ev, w)
+			}
+			m.HandleEvent(ev)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("event %d (%v) not received", i, w)
+		}
+	}
+	if fired["CTRL+A"] != 1 {
+		t.Errorf("CTRL+A fired %d times, want 1", fired["CTRL+A"])
+	}
+}
+
+func TestManagerForRealDevice(t *testing.T) {
+	dev, path := createKeyboard(t)
+	fired := make(chan struct{}, 1)
+	m, err := NewManagerForDevice(path)
+	if err != nil {
+		t.Fatalf("NewManagerForDevice(%s): %v", path, err)
+	}
+	defer m.Close()
+	m.RegisterBinding("CTRL+A", func() { fired <- struct{}{} })
+
+	typeCtrlA(t, dev)
+	select {
+	case <-fired:
+	case <-time.After(2 * time.Second):
+		t.Fatal("CTRL+A did not fire")
+	}
+}
+
+func TestListenAllStopsOnCancel(t *testing.T) {
+	dev, path := createKeyboard(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := ListenAll(ctx, path)
+	if err != nil {
+		t.Fatalf("ListenAll(%s): %v", path, err)
+	}
+	// nothing receives, so the reading goroutine blocks sending an event
+	typeCtrlA(t, dev)
+	cancel()
+	deadline := time.After(2 * time.Second)
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if ev == (Event{Type: Disconnect}) {
+				t.Fatal("Disconnect sent after cancellation")
+			}
+		case <-deadline:
+			t.Fatal("channel not closed after cancellation")
+		}
+	}
+}
@@ -0,0 +1,35 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// evioc returns the evdev ioctl request number for dir, nr and the
+// argument size, like the kernel's _IOC(dir, 'E', nr, size) macro. The
+// direction bits and the width of the size field depend on the
+// architecture; see iocDirShift.
+//
+// Only the package's own requests, EVIOCGREP and EVIOCGKEYCODE_V2 /
+// EVIOCSKEYCODE_V2, are encoded here. Devices are opened and queried with
+// go-evdev, which issues its requests (including EVIOCGRAB, EVIOCGKEY,
+// EVIOCGLED and EVIOCGVERSION) with the generic layout, so device access
+// as a whole is only supported on architectures using it.
+func evioc(dir, nr, size uintptr) uintptr {
+	return dir<<iocDirShift | size<<iocSizeShift | 'E'<<8 | nr
+}
+
+// iocSizeShift is the position of the argument size in an ioctl request.
+const iocSizeShift = 16
+
+// ioctl performs the ioctl request req on fd with the argument arg.
+func ioctl(fd, req uintptr, arg unsafe.Pointer) error {
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
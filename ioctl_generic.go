@@ -0,0 +1,12 @@
+//go:build linux && !(mips || mipsle || mips64 || mips64le || ppc64 || ppc64le)
+
+package keyboard
+
+// ioctl request directions and the position of the direction bits, as
+// encoded by the kernel's generic _IOC macro used on x86, arm, arm64,
+// riscv64, s390x and loong64.
+const (
+	iocWrite    = 1
+	iocRead     = 2
+	iocDirShift = 30
+)
@@ -0,0 +1,11 @@
+//go:build linux && (mips || mipsle || mips64 || mips64le || ppc64 || ppc64le)
+
+package keyboard
+
+// ioctl request directions and the position of the direction bits on mips
+// and powerpc, whose _IOC macro has three direction bits and a 13-bit size.
+const (
+	iocRead     = 2
+	iocWrite    = 4
+	iocDirShift = 29
+)
@@ -0,0 +1,26 @@
+//go:build linux && (amd64 || arm64 || riscv64)
+
+package keyboard
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestEvioc(t *testing.T) {
+	var rep [2]uint32
+	for _, tc := range []struct {
+		name string
+		got  uintptr
+		want uintptr
+	}{
+		// values from <linux/input.h> on x86-64
+		{"EVIOCGREP", evioc(iocRead, 0x03, unsafe.Sizeof(rep)), 0x80084503},
+		{"EVIOCGKEYCODE_V2", evioc(iocRead, 0x04, unsafe.Sizeof(keymapEntry{})), 0x80284504},
+		{"EVIOCSKEYCODE_V2", evioc(iocWrite, 0x04, unsafe.Sizeof(keymapEntry{})), 0x40284504},
+	} {
+		if tc.got != tc.want {
+			t.Errorf("%s = %#x, want %#x", tc.name, tc.got, tc.want)
+		}
+	}
+}
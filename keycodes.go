@@ -0,0 +1,1329 @@
+// Code generated by gen_keycodes.go; DO NOT EDIT.
+
+package keyboard
+
+// Names of the evdev key codes, for use in combos and events (e.g.,
+// KeyCodeLeftCtrl is "KEY_LEFTCTRL"). Names sharing a code are aliases.
+const (
+	KeyCodeReserved                = "KEY_RESERVED"
+	KeyCodeEsc                     = "KEY_ESC"
+	KeyCode1                       = "KEY_1"
+	KeyCode2                       = "KEY_2"
+	KeyCode3                       = "KEY_3"
+	KeyCode4                       = "KEY_4"
+	KeyCode5                       = "KEY_5"
+	KeyCode6                       = "KEY_6"
+	KeyCode7                       = "KEY_7"
+	KeyCode8                       = "KEY_8"
+	KeyCode9                       = "KEY_9"
+	KeyCode0                       = "KEY_0"
+	KeyCodeMinus                   = "KEY_MINUS"
+	KeyCodeEqual                   = "KEY_EQUAL"
+	KeyCodeBackspace               = "KEY_BACKSPACE"
+	KeyCodeTab                     = "KEY_TAB"
+	KeyCodeQ                       = "KEY_Q"
+	KeyCodeW                       = "KEY_W"
+	KeyCodeE                       = "KEY_E"
+	KeyCodeR                       = "KEY_R"
+	KeyCodeT                       = "KEY_T"
+	KeyCodeY                       = "KEY_Y"
+	KeyCodeU                       = "KEY_U"
+	KeyCodeI                       = "KEY_I"
+	KeyCodeO                       = "KEY_O"
+	KeyCodeP                       = "KEY_P"
+	KeyCodeLeftBrace               = "KEY_LEFTBRACE"
+	KeyCodeRightBrace              = "KEY_RIGHTBRACE"
+	KeyCodeEnter                   = "KEY_ENTER"
+	KeyCodeLeftCtrl                = "KEY_LEFTCTRL"
+	KeyCodeA                       = "KEY_A"
+	KeyCodeS                       = "KEY_S"
+	KeyCodeD                       = "KEY_D"
+	KeyCodeF                       = "KEY_F"
+	KeyCodeG                       = "KEY_G"
+	KeyCodeH                       = "KEY_H"
+	KeyCodeJ                       = "KEY_J"
+	KeyCodeK                       = "KEY_K"
+	KeyCodeL                       = "KEY_L"
+	KeyCodeSemicolon               = "KEY_SEMICOLON"
+	KeyCodeApostrophe              = "KEY_APOSTROPHE"
+	KeyCodeGrave                   = "KEY_GRAVE"
+	KeyCodeLeftShift               = "KEY_LEFTSHIFT"
+	KeyCodeBackslash               = "KEY_BACKSLASH"
+	KeyCodeZ                       = "KEY_Z"
+	KeyCodeX                       = "KEY_X"
+	KeyCodeC                       = "KEY_C"
+	KeyCodeV                       = "KEY_V"
+	KeyCodeB                       = "KEY_B"
+	KeyCodeN                       = "KEY_N"
+	KeyCodeM                       = "KEY_M"
+	KeyCodeComma                   = "KEY_COMMA"
+	KeyCodeDot                     = "KEY_DOT"
+	KeyCodeSlash                   = "KEY_SLASH"
+	KeyCodeRightShift              = "KEY_RIGHTSHIFT"
+	KeyCodeKpAsterisk              = "KEY_KPASTERISK"
+	KeyCodeLeftAlt                 = "KEY_LEFTALT"
+	KeyCodeSpace                   = "KEY_SPACE"
+	KeyCodeCapslock                = "KEY_CAPSLOCK"
+	KeyCodeF1                      = "KEY_F1"
+	KeyCodeF2                      = "KEY_F2"
+	KeyCodeF3                      = "KEY_F3"
+	KeyCodeF4                      = "KEY_F4"
+	KeyCodeF5                      = "KEY_F5"
+	KeyCodeF6                      = "KEY_F6"
+	KeyCodeF7                      = "KEY_F7"
+	KeyCodeF8                      = "KEY_F8"
+	KeyCodeF9                      = "KEY_F9"
+	KeyCodeF10                     = "KEY_F10"
+	KeyCodeNumlock                 = "KEY_NUMLOCK"
+	KeyCodeScrolllock              = "KEY_SCROLLLOCK"
+	KeyCodeKp7                     = "KEY_KP7"
+	KeyCodeKp8                     = "KEY_KP8"
+	KeyCodeKp9                     = "KEY_KP9"
+	KeyCodeKpMinus                 = "KEY_KPMINUS"
+	KeyCodeKp4                     = "KEY_KP4"
+	KeyCodeKp5                     = "KEY_KP5"
+	KeyCodeKp6                     = "KEY_KP6"
+	KeyCodeKpPlus                  = "KEY_KPPLUS"
+	KeyCodeKp1                     = "KEY_KP1"
+	KeyCodeKp2                     = "KEY_KP2"
+	KeyCodeKp3                     = "KEY_KP3"
+	KeyCodeKp0                     = "KEY_KP0"
+	KeyCodeKpDot                   = "KEY_KPDOT"
+	KeyCodeZenkakuhankaku          = "KEY_ZENKAKUHANKAKU"
+	KeyCode102nd                   = "KEY_102ND"
+	KeyCodeF11                     = "KEY_F11"
+	KeyCodeF12                     = "KEY_F12"
+	KeyCodeRo                      = "KEY_RO"
+	KeyCodeKatakana                = "KEY_KATAKANA"
+	KeyCodeHiragana                = "KEY_HIRAGANA"
+	KeyCodeHenkan                  = "KEY_HENKAN"
+	KeyCodeKatakanahiragana        = "KEY_KATAKANAHIRAGANA"
+	KeyCodeMuhenkan                = "KEY_MUHENKAN"
+	KeyCodeKpJpcomma               = "KEY_KPJPCOMMA"
+	KeyCodeKpEnter                 = "KEY_KPENTER"
+	KeyCodeRightCtrl               = "KEY_RIGHTCTRL"
+	KeyCodeKpSlash                 = "KEY_KPSLASH"
+	KeyCodeSysrq                   = "KEY_SYSRQ"
+	KeyCodeRightAlt                = "KEY_RIGHTALT"
+	KeyCodeLinefeed                = "KEY_LINEFEED"
+	KeyCodeHome                    = "KEY_HOME"
+	KeyCodeUp                      = "KEY_UP"
+	KeyCodePageup                  = "KEY_PAGEUP"
+	KeyCodeLeft                    = "KEY_LEFT"
+	KeyCodeRight                   = "KEY_RIGHT"
+	KeyCodeEnd                     = "KEY_END"
+	KeyCodeDown                    = "KEY_DOWN"
+	KeyCodePagedown                = "KEY_PAGEDOWN"
+	KeyCodeInsert                  = "KEY_INSERT"
+	KeyCodeDelete                  = "KEY_DELETE"
+	KeyCodeMacro                   = "KEY_MACRO"
+	KeyCodeMinInteresting          = "KEY_MIN_INTERESTING"
+	KeyCodeMute                    = "KEY_MUTE"
+	KeyCodeVolumedown              = "KEY_VOLUMEDOWN"
+	KeyCodeVolumeup                = "KEY_VOLUMEUP"
+	KeyCodePower                   = "KEY_POWER"
+	KeyCodeKpEqual                 = "KEY_KPEQUAL"
+	KeyCodeKpPlusminus             = "KEY_KPPLUSMINUS"
+	KeyCodePause                   = "KEY_PAUSE"
+	KeyCodeScale                   = "KEY_SCALE"
+	KeyCodeKpComma                 = "KEY_KPCOMMA"
+	KeyCodeHangeul                 = "KEY_HANGEUL"
+	KeyCodeHanguel                 = "KEY_HANGUEL"
+	KeyCodeHanja                   = "KEY_HANJA"
+	KeyCodeYen                     = "KEY_YEN"
+	KeyCodeLeftMeta                = "KEY_LEFTMETA"
+	KeyCodeRightMeta               = "KEY_RIGHTMETA"
+	KeyCodeCompose                 = "KEY_COMPOSE"
+	KeyCodeStop                    = "KEY_STOP"
+	KeyCodeAgain                   = "KEY_AGAIN"
+	KeyCodeProps                   = "KEY_PROPS"
+	KeyCodeUndo                    = "KEY_UNDO"
+	KeyCodeFront                   = "KEY_FRONT"
+	KeyCodeCopy                    = "KEY_COPY"
+	KeyCodeOpen                    = "KEY_OPEN"
+	KeyCodePaste                   = "KEY_PASTE"
+	KeyCodeFind                    = "KEY_FIND"
+	KeyCodeCut                     = "KEY_CUT"
+	KeyCodeHelp                    = "KEY_HELP"
+	KeyCodeMenu                    = "KEY_MENU"
+	KeyCodeCalc                    = "KEY_CALC"
+	KeyCodeSetup                   = "KEY_SETUP"
+	KeyCodeSleep                   = "KEY_SLEEP"
+	KeyCodeWakeup                  = "KEY_WAKEUP"
+	KeyCodeFile                    = "KEY_FILE"
+	KeyCodeSendfile                = "KEY_SENDFILE"
+	KeyCodeDeletefile              = "KEY_DELETEFILE"
+	KeyCodeXfer                    = "KEY_XFER"
+	KeyCodeProg1                   = "KEY_PROG1"
+	KeyCodeProg2                   = "KEY_PROG2"
+	KeyCodeWww                     = "KEY_WWW"
+	KeyCodeMsdos                   = "KEY_MSDOS"
+	KeyCodeCoffee                  = "KEY_COFFEE"
+	KeyCodeScreenlock              = "KEY_SCREENLOCK"
+	KeyCodeDirection               = "KEY_DIRECTION"
+	KeyCodeRotateDisplay           = "KEY_ROTATE_DISPLAY"
+	KeyCodeCyclewindows            = "KEY_CYCLEWINDOWS"
+	KeyCodeMail                    = "KEY_MAIL"
+	KeyCodeBookmarks               = "KEY_BOOKMARKS"
+	KeyCodeComputer                = "KEY_COMPUTER"
+	KeyCodeBack                    = "KEY_BACK"
+	KeyCodeForward                 = "KEY_FORWARD"
+	KeyCodeClosecd                 = "KEY_CLOSECD"
+	KeyCodeEjectcd                 = "KEY_EJECTCD"
+	KeyCodeEjectclosecd            = "KEY_EJECTCLOSECD"
+	KeyCodeNextsong                = "KEY_NEXTSONG"
+	KeyCodePlaypause               = "KEY_PLAYPAUSE"
+	KeyCodePrevioussong            = "KEY_PREVIOUSSONG"
+	KeyCodeStopcd                  = "KEY_STOPCD"
+	KeyCodeRecord                  = "KEY_RECORD"
+	KeyCodeRewind                  = "KEY_REWIND"
+	KeyCodePhone                   = "KEY_PHONE"
+	KeyCodeIso                     = "KEY_ISO"
+	KeyCodeConfig                  = "KEY_CONFIG"
+	KeyCodeHomepage                = "KEY_HOMEPAGE"
+	KeyCodeRefresh                 = "KEY_REFRESH"
+	KeyCodeExit                    = "KEY_EXIT"
+	KeyCodeMove                    = "KEY_MOVE"
+	KeyCodeEdit                    = "KEY_EDIT"
+	KeyCodeScrollup                = "KEY_SCROLLUP"
+	KeyCodeScrolldown              = "KEY_SCROLLDOWN"
+	KeyCodeKpLeftparen             = "KEY_KPLEFTPAREN"
+	KeyCodeKpRightparen            = "KEY_KPRIGHTPAREN"
+	KeyCodeNew                     = "KEY_NEW"
+	KeyCodeRedo                    = "KEY_REDO"
+	KeyCodeF13                     = "KEY_F13"
+	KeyCodeF14                     = "KEY_F14"
+	KeyCodeF15                     = "KEY_F15"
+	KeyCodeF16                     = "KEY_F16"
+	KeyCodeF17                     = "KEY_F17"
+	KeyCodeF18                     = "KEY_F18"
+	KeyCodeF19                     = "KEY_F19"
+	KeyCodeF20                     = "KEY_F20"
+	KeyCodeF21                     = "KEY_F21"
+	KeyCodeF22                     = "KEY_F22"
+	KeyCodeF23                     = "KEY_F23"
+	KeyCodeF24                     = "KEY_F24"
+	KeyCodePlaycd                  = "KEY_PLAYCD"
+	KeyCodePausecd                 = "KEY_PAUSECD"
+	KeyCodeProg3                   = "KEY_PROG3"
+	KeyCodeProg4                   = "KEY_PROG4"
+	KeyCodeAllApplications         = "KEY_ALL_APPLICATIONS"
+	KeyCodeDashboard               = "KEY_DASHBOARD"
+	KeyCodeSuspend                 = "KEY_SUSPEND"
+	KeyCodeClose                   = "KEY_CLOSE"
+	KeyCodePlay                    = "KEY_PLAY"
+	KeyCodeFastforward             = "KEY_FASTFORWARD"
+	KeyCodeBassboost               = "KEY_BASSBOOST"
+	KeyCodePrint                   = "KEY_PRINT"
+	KeyCodeHp                      = "KEY_HP"
+	KeyCodeCamera                  = "KEY_CAMERA"
+	KeyCodeSound                   = "KEY_SOUND"
+	KeyCodeQuestion                = "KEY_QUESTION"
+	KeyCodeEmail                   = "KEY_EMAIL"
+	KeyCodeChat                    = "KEY_CHAT"
+	KeyCodeSearch                  = "KEY_SEARCH"
+	KeyCodeConnect                 = "KEY_CONNECT"
+	KeyCodeFinance                 = "KEY_FINANCE"
+	KeyCodeSport                   = "KEY_SPORT"
+	KeyCodeShop                    = "KEY_SHOP"
+	KeyCodeAlterase                = "KEY_ALTERASE"
+	KeyCodeCancel                  = "KEY_CANCEL"
+	KeyCodeBrightnessdown          = "KEY_BRIGHTNESSDOWN"
+	KeyCodeBrightnessup            = "KEY_BRIGHTNESSUP"
+	KeyCodeMedia                   = "KEY_MEDIA"
+	KeyCodeSwitchvideomode         = "KEY_SWITCHVIDEOMODE"
+	KeyCodeKbdillumtoggle          = "KEY_KBDILLUMTOGGLE"
+	KeyCodeKbdillumdown            = "KEY_KBDILLUMDOWN"
+	KeyCodeKbdillumup              = "KEY_KBDILLUMUP"
+	KeyCodeSend                    = "KEY_SEND"
+	KeyCodeReply                   = "KEY_REPLY"
+	KeyCodeForwardmail             = "KEY_FORWARDMAIL"
+	KeyCodeSave                    = "KEY_SAVE"
+	KeyCodeDocuments               = "KEY_DOCUMENTS"
+	KeyCodeBattery                 = "KEY_BATTERY"
+	KeyCodeBluetooth               = "KEY_BLUETOOTH"
+	KeyCodeWlan                    = "KEY_WLAN"
+	KeyCodeUwb                     = "KEY_UWB"
+	KeyCodeUnknown                 = "KEY_UNKNOWN"
+	KeyCodeVideoNext               = "KEY_VIDEO_NEXT"
+	KeyCodeVideoPrev               = "KEY_VIDEO_PREV"
+	KeyCodeBrightnessCycle         = "KEY_BRIGHTNESS_CYCLE"
+	KeyCodeBrightnessAuto          = "KEY_BRIGHTNESS_AUTO"
+	KeyCodeBrightnessZero          = "KEY_BRIGHTNESS_ZERO"
+	KeyCodeDisplayOff              = "KEY_DISPLAY_OFF"
+	KeyCodeWimax                   = "KEY_WIMAX"
+	KeyCodeWwan                    = "KEY_WWAN"
+	KeyCodeRfkill                  = "KEY_RFKILL"
+	KeyCodeMicmute                 = "KEY_MICMUTE"
+	KeyCodeBtn0                    = "BTN_0"
+	KeyCodeBtnMisc                 = "BTN_MISC"
+	KeyCodeBtn1                    = "BTN_1"
+	KeyCodeBtn2                    = "BTN_2"
+	KeyCodeBtn3                    = "BTN_3"
+	KeyCodeBtn4                    = "BTN_4"
+	KeyCodeBtn5                    = "BTN_5"
+	KeyCodeBtn6                    = "BTN_6"
+	KeyCodeBtn7                    = "BTN_7"
+	KeyCodeBtn8                    = "BTN_8"
+	KeyCodeBtn9                    = "BTN_9"
+	KeyCodeBtnLeft                 = "BTN_LEFT"
+	KeyCodeBtnMouse                = "BTN_MOUSE"
+	KeyCodeBtnRight                = "BTN_RIGHT"
+	KeyCodeBtnMiddle               = "BTN_MIDDLE"
+	KeyCodeBtnSide                 = "BTN_SIDE"
+	KeyCodeBtnExtra                = "BTN_EXTRA"
+	KeyCodeBtnForward              = "BTN_FORWARD"
+	KeyCodeBtnBack                 = "BTN_BACK"
+	KeyCodeBtnTask                 = "BTN_TASK"
+	KeyCodeBtnJoystick             = "BTN_JOYSTICK"
+	KeyCodeBtnTrigger              = "BTN_TRIGGER"
+	KeyCodeBtnThumb                = "BTN_THUMB"
+	KeyCodeBtnThumb2               = "BTN_THUMB2"
+	KeyCodeBtnTop                  = "BTN_TOP"
+	KeyCodeBtnTop2                 = "BTN_TOP2"
+	KeyCodeBtnPinkie               = "BTN_PINKIE"
+	KeyCodeBtnBase                 = "BTN_BASE"
+	KeyCodeBtnBase2                = "BTN_BASE2"
+	KeyCodeBtnBase3                = "BTN_BASE3"
+	KeyCodeBtnBase4                = "BTN_BASE4"
+	KeyCodeBtnBase5                = "BTN_BASE5"
+	KeyCodeBtnBase6                = "BTN_BASE6"
+	KeyCodeBtnDead                 = "BTN_DEAD"
+	KeyCodeBtnA                    = "BTN_A"
+	KeyCodeBtnGamepad              = "BTN_GAMEPAD"
+	KeyCodeBtnSouth                = "BTN_SOUTH"
+	KeyCodeBtnB                    = "BTN_B"
+	KeyCodeBtnEast                 = "BTN_EAST"
+	KeyCodeBtnC                    = "BTN_C"
+	KeyCodeBtnNorth                = "BTN_NORTH"
+	KeyCodeBtnX                    = "BTN_X"
+	KeyCodeBtnWest                 = "BTN_WEST"
+	KeyCodeBtnY                    = "BTN_Y"
+	KeyCodeBtnZ                    = "BTN_Z"
+	KeyCodeBtnTl                   = "BTN_TL"
+	KeyCodeBtnTr                   = "BTN_TR"
+	KeyCodeBtnTl2                  = "BTN_TL2"
+	KeyCodeBtnTr2                  = "BTN_TR2"
+	KeyCodeBtnSelect               = "BTN_SELECT"
+	KeyCodeBtnStart                = "BTN_START"
+	KeyCodeBtnMode                 = "BTN_MODE"
+	KeyCodeBtnThumbl               = "BTN_THUMBL"
+	KeyCodeBtnThumbr               = "BTN_THUMBR"
+	KeyCodeBtnDigi                 = "BTN_DIGI"
+	KeyCodeBtnToolPen              = "BTN_TOOL_PEN"
+	KeyCodeBtnToolRubber           = "BTN_TOOL_RUBBER"
+	KeyCodeBtnToolBrush            = "BTN_TOOL_BRUSH"
+	KeyCodeBtnToolPencil           = "BTN_TOOL_PENCIL"
+	KeyCodeBtnToolAirbrush         = "BTN_TOOL_AIRBRUSH"
+	KeyCodeBtnToolFinger           = "BTN_TOOL_FINGER"
+	KeyCodeBtnToolMouse            = "BTN_TOOL_MOUSE"
+	KeyCodeBtnToolLens             = "BTN_TOOL_LENS"
+	KeyCodeBtnToolQuinttap         = "BTN_TOOL_QUINTTAP"
+	KeyCodeBtnStylus3              = "BTN_STYLUS3"
+	KeyCodeBtnTouch                = "BTN_TOUCH"
+	KeyCodeBtnStylus               = "BTN_STYLUS"
+	KeyCodeBtnStylus2              = "BTN_STYLUS2"
+	KeyCodeBtnToolDoubletap        = "BTN_TOOL_DOUBLETAP"
+	KeyCodeBtnToolTripletap        = "BTN_TOOL_TRIPLETAP"
+	KeyCodeBtnToolQuadtap          = "BTN_TOOL_QUADTAP"
+	KeyCodeBtnGearDown             = "BTN_GEAR_DOWN"
+	KeyCodeBtnWheel                = "BTN_WHEEL"
+	KeyCodeBtnGearUp               = "BTN_GEAR_UP"
+	KeyCodeOk                      = "KEY_OK"
+	KeyCodeSelect                  = "KEY_SELECT"
+	KeyCodeGoto                    = "KEY_GOTO"
+	KeyCodeClear                   = "KEY_CLEAR"
+	KeyCodePower2                  = "KEY_POWER2"
+	KeyCodeOption                  = "KEY_OPTION"
+	KeyCodeInfo                    = "KEY_INFO"
+	KeyCodeTime                    = "KEY_TIME"
+	KeyCodeVendor                  = "KEY_VENDOR"
+	KeyCodeArchive                 = "KEY_ARCHIVE"
+	KeyCodeProgram                 = "KEY_PROGRAM"
+	KeyCodeChannel                 = "KEY_CHANNEL"
+	KeyCodeFavorites               = "KEY_FAVORITES"
+	KeyCodeEpg                     = "KEY_EPG"
+	KeyCodePvr                     = "KEY_PVR"
+	KeyCodeMhp                     = "KEY_MHP"
+	KeyCodeLanguage                = "KEY_LANGUAGE"
+	KeyCodeTitle                   = "KEY_TITLE"
+	KeyCodeSubtitle                = "KEY_SUBTITLE"
+	KeyCodeAngle                   = "KEY_ANGLE"
+	KeyCodeFullScreen              = "KEY_FULL_SCREEN"
+	KeyCodeZoom                    = "KEY_ZOOM"
+	KeyCodeMode                    = "KEY_MODE"
+	KeyCodeKeyboard                = "KEY_KEYBOARD"
+	KeyCodeAspectRatio             = "KEY_ASPECT_RATIO"
+	KeyCodeScreen                  = "KEY_SCREEN"
+	KeyCodePc                      = "KEY_PC"
+	KeyCodeTv                      = "KEY_TV"
+	KeyCodeTv2                     = "KEY_TV2"
+	KeyCodeVcr                     = "KEY_VCR"
+	KeyCodeVcr2                    = "KEY_VCR2"
+	KeyCodeSat                     = "KEY_SAT"
+	KeyCodeSat2                    = "KEY_SAT2"
+	KeyCodeCd                      = "KEY_CD"
+	KeyCodeTape                    = "KEY_TAPE"
+	KeyCodeRadio                   = "KEY_RADIO"
+	KeyCodeTuner                   = "KEY_TUNER"
+	KeyCodePlayer                  = "KEY_PLAYER"
+	KeyCodeText                    = "KEY_TEXT"
+	KeyCodeDvd                     = "KEY_DVD"
+	KeyCodeAux                     = "KEY_AUX"
+	KeyCodeMp3                     = "KEY_MP3"
+	KeyCodeAudio                   = "KEY_AUDIO"
+	KeyCodeVideo                   = "KEY_VIDEO"
+	KeyCodeDirectory               = "KEY_DIRECTORY"
+	KeyCodeList                    = "KEY_LIST"
+	KeyCodeMemo                    = "KEY_MEMO"
+	KeyCodeCalendar                = "KEY_CALENDAR"
+	KeyCodeRed                     = "KEY_RED"
+	KeyCodeGreen                   = "KEY_GREEN"
+	KeyCodeYellow                  = "KEY_YELLOW"
+	KeyCodeBlue                    = "KEY_BLUE"
+	KeyCodeChannelup               = "KEY_CHANNELUP"
+	KeyCodeChanneldown             = "KEY_CHANNELDOWN"
+	KeyCodeFirst                   = "KEY_FIRST"
+	KeyCodeLast                    = "KEY_LAST"
+	KeyCodeAb                      = "KEY_AB"
+	KeyCodeNext                    = "KEY_NEXT"
+	KeyCodeRestart                 = "KEY_RESTART"
+	KeyCodeSlow                    = "KEY_SLOW"
+	KeyCodeShuffle                 = "KEY_SHUFFLE"
+	KeyCodeBreak                   = "KEY_BREAK"
+	KeyCodePrevious                = "KEY_PREVIOUS"
+	KeyCodeDigits                  = "KEY_DIGITS"
+	KeyCodeTeen                    = "KEY_TEEN"
+	KeyCodeTwen                    = "KEY_TWEN"
+	KeyCodeVideophone              = "KEY_VIDEOPHONE"
+	KeyCodeGames                   = "KEY_GAMES"
+	KeyCodeZoomin                  = "KEY_ZOOMIN"
+	KeyCodeZoomout                 = "KEY_ZOOMOUT"
+	KeyCodeZoomreset               = "KEY_ZOOMRESET"
+	KeyCodeWordprocessor           = "KEY_WORDPROCESSOR"
+	KeyCodeEditor                  = "KEY_EDITOR"
+	KeyCodeSpreadsheet             = "KEY_SPREADSHEET"
+	KeyCodeGraphicseditor          = "KEY_GRAPHICSEDITOR"
+	KeyCodePresentation            = "KEY_PRESENTATION"
+	KeyCodeDatabase                = "KEY_DATABASE"
+	KeyCodeNews                    = "KEY_NEWS"
+	KeyCodeVoicemail               = "KEY_VOICEMAIL"
+	KeyCodeAddressbook             = "KEY_ADDRESSBOOK"
+	KeyCodeMessenger               = "KEY_MESSENGER"
+	KeyCodeBrightnessToggle        = "KEY_BRIGHTNESS_TOGGLE"
+	KeyCodeDisplaytoggle           = "KEY_DISPLAYTOGGLE"
+	KeyCodeSpellcheck              = "KEY_SPELLCHECK"
+	KeyCodeLogoff                  = "KEY_LOGOFF"
+	KeyCodeDollar                  = "KEY_DOLLAR"
+	KeyCodeEuro                    = "KEY_EURO"
+	KeyCodeFrameback               = "KEY_FRAMEBACK"
+	KeyCodeFrameforward            = "KEY_FRAMEFORWARD"
+	KeyCodeContextMenu             = "KEY_CONTEXT_MENU"
+	KeyCodeMediaRepeat             = "KEY_MEDIA_REPEAT"
+	KeyCode10channelsup            = "KEY_10CHANNELSUP"
+	KeyCode10channelsdown          = "KEY_10CHANNELSDOWN"
+	KeyCodeImages                  = "KEY_IMAGES"
+	KeyCodeNotificationCenter      = "KEY_NOTIFICATION_CENTER"
+	KeyCodePickupPhone             = "KEY_PICKUP_PHONE"
+	KeyCodeHangupPhone             = "KEY_HANGUP_PHONE"
+	KeyCodeDelEol                  = "KEY_DEL_EOL"
+	KeyCodeDelEos                  = "KEY_DEL_EOS"
+	KeyCodeInsLine                 = "KEY_INS_LINE"
+	KeyCodeDelLine                 = "KEY_DEL_LINE"
+	KeyCodeFn                      = "KEY_FN"
+	KeyCodeFnEsc                   = "KEY_FN_ESC"
+	KeyCodeFnF1                    = "KEY_FN_F1"
+	KeyCodeFnF2                    = "KEY_FN_F2"
+	KeyCodeFnF3                    = "KEY_FN_F3"
+	KeyCodeFnF4                    = "KEY_FN_F4"
+	KeyCodeFnF5                    = "KEY_FN_F5"
+	KeyCodeFnF6                    = "KEY_FN_F6"
+	KeyCodeFnF7                    = "KEY_FN_F7"
+	KeyCodeFnF8                    = "KEY_FN_F8"
+	KeyCodeFnF9                    = "KEY_FN_F9"
+	KeyCodeFnF10                   = "KEY_FN_F10"
+	KeyCodeFnF11                   = "KEY_FN_F11"
+	KeyCodeFnF12                   = "KEY_FN_F12"
+	KeyCodeFn1                     = "KEY_FN_1"
+	KeyCodeFn2                     = "KEY_FN_2"
+	KeyCodeFnD                     = "KEY_FN_D"
+	KeyCodeFnE                     = "KEY_FN_E"
+	KeyCodeFnF                     = "KEY_FN_F"
+	KeyCodeFnS                     = "KEY_FN_S"
+	KeyCodeFnB                     = "KEY_FN_B"
+	KeyCodeFnRightShift            = "KEY_FN_RIGHT_SHIFT"
+	KeyCodeBrlDot1                 = "KEY_BRL_DOT1"
+	KeyCodeBrlDot2                 = "KEY_BRL_DOT2"
+	KeyCodeBrlDot3                 = "KEY_BRL_DOT3"
+	KeyCodeBrlDot4                 = "KEY_BRL_DOT4"
+	KeyCodeBrlDot5                 = "KEY_BRL_DOT5"
+	KeyCodeBrlDot6                 = "KEY_BRL_DOT6"
+	KeyCodeBrlDot7                 = "KEY_BRL_DOT7"
+	KeyCodeBrlDot8                 = "KEY_BRL_DOT8"
+	KeyCodeBrlDot9                 = "KEY_BRL_DOT9"
+	KeyCodeBrlDot10                = "KEY_BRL_DOT10"
+	KeyCodeNumeric0                = "KEY_NUMERIC_0"
+	KeyCodeNumeric1                = "KEY_NUMERIC_1"
+	KeyCodeNumeric2                = "KEY_NUMERIC_2"
+	KeyCodeNumeric3                = "KEY_NUMERIC_3"
+	KeyCodeNumeric4                = "KEY_NUMERIC_4"
+	KeyCodeNumeric5                = "KEY_NUMERIC_5"
+	KeyCodeNumeric6                = "KEY_NUMERIC_6"
+	KeyCodeNumeric7                = "KEY_NUMERIC_7"
+	KeyCodeNumeric8                = "KEY_NUMERIC_8"
+	KeyCodeNumeric9                = "KEY_NUMERIC_9"
+	KeyCodeNumericStar             = "KEY_NUMERIC_STAR"
+	KeyCodeNumericPound            = "KEY_NUMERIC_POUND"
+	KeyCodeNumericA                = "KEY_NUMERIC_A"
+	KeyCodeNumericB                = "KEY_NUMERIC_B"
+	KeyCodeNumericC                = "KEY_NUMERIC_C"
+	KeyCodeNumericD                = "KEY_NUMERIC_D"
+	KeyCodeCameraFocus             = "KEY_CAMERA_FOCUS"
+	KeyCodeWpsButton               = "KEY_WPS_BUTTON"
+	KeyCodeTouchpadToggle          = "KEY_TOUCHPAD_TOGGLE"
+	KeyCodeTouchpadOn              = "KEY_TOUCHPAD_ON"
+	KeyCodeTouchpadOff             = "KEY_TOUCHPAD_OFF"
+	KeyCodeCameraZoomin            = "KEY_CAMERA_ZOOMIN"
+	KeyCodeCameraZoomout           = "KEY_CAMERA_ZOOMOUT"
+	KeyCodeCameraUp                = "KEY_CAMERA_UP"
+	KeyCodeCameraDown              = "KEY_CAMERA_DOWN"
+	KeyCodeCameraLeft              = "KEY_CAMERA_LEFT"
+	KeyCodeCameraRight             = "KEY_CAMERA_RIGHT"
+	KeyCodeAttendantOn             = "KEY_ATTENDANT_ON"
+	KeyCodeAttendantOff            = "KEY_ATTENDANT_OFF"
+	KeyCodeAttendantToggle         = "KEY_ATTENDANT_TOGGLE"
+	KeyCodeLightsToggle            = "KEY_LIGHTS_TOGGLE"
+	KeyCodeBtnDpadUp               = "BTN_DPAD_UP"
+	KeyCodeBtnDpadDown             = "BTN_DPAD_DOWN"
+	KeyCodeBtnDpadLeft             = "BTN_DPAD_LEFT"
+	KeyCodeBtnDpadRight            = "BTN_DPAD_RIGHT"
+	KeyCodeAlsToggle               = "KEY_ALS_TOGGLE"
+	KeyCodeRotateLockToggle        = "KEY_ROTATE_LOCK_TOGGLE"
+	KeyCodeButtonconfig            = "KEY_BUTTONCONFIG"
+	KeyCodeTaskmanager             = "KEY_TASKMANAGER"
+	KeyCodeJournal                 = "KEY_JOURNAL"
+	KeyCodeControlpanel            = "KEY_CONTROLPANEL"
+	KeyCodeAppselect               = "KEY_APPSELECT"
+	KeyCodeScreensaver             = "KEY_SCREENSAVER"
+	KeyCodeVoicecommand            = "KEY_VOICECOMMAND"
+	KeyCodeAssistant               = "KEY_ASSISTANT"
+	KeyCodeKbdLayoutNext           = "KEY_KBD_LAYOUT_NEXT"
+	KeyCodeEmojiPicker             = "KEY_EMOJI_PICKER"
+	KeyCodeDictate                 = "KEY_DICTATE"
+	KeyCodeCameraAccessEnable      = "KEY_CAMERA_ACCESS_ENABLE"
+	KeyCodeCameraAccessDisable     = "KEY_CAMERA_ACCESS_DISABLE"
+	KeyCodeCameraAccessToggle      = "KEY_CAMERA_ACCESS_TOGGLE"
+	KeyCodeBrightnessMin           = "KEY_BRIGHTNESS_MIN"
+	KeyCodeBrightnessMax           = "KEY_BRIGHTNESS_MAX"
+	KeyCodeKbdinputassistPrev      = "KEY_KBDINPUTASSIST_PREV"
+	KeyCodeKbdinputassistNext      = "KEY_KBDINPUTASSIST_NEXT"
+	KeyCodeKbdinputassistPrevgroup = "KEY_KBDINPUTASSIST_PREVGROUP"
+	KeyCodeKbdinputassistNextgroup = "KEY_KBDINPUTASSIST_NEXTGROUP"
+	KeyCodeKbdinputassistAccept    = "KEY_KBDINPUTASSIST_ACCEPT"
+	KeyCodeKbdinputassistCancel    = "KEY_KBDINPUTASSIST_CANCEL"
+	KeyCodeRightUp                 = "KEY_RIGHT_UP"
+	KeyCodeRightDown               = "KEY_RIGHT_DOWN"
+	KeyCodeLeftUp                  = "KEY_LEFT_UP"
+	KeyCodeLeftDown                = "KEY_LEFT_DOWN"
+	KeyCodeRootMenu                = "KEY_ROOT_MENU"
+	KeyCodeMediaTopMenu            = "KEY_MEDIA_TOP_MENU"
+	KeyCodeNumeric11               = "KEY_NUMERIC_11"
+	KeyCodeNumeric12               = "KEY_NUMERIC_12"
+	KeyCodeAudioDesc               = "KEY_AUDIO_DESC"
+	KeyCode3dMode                  = "KEY_3D_MODE"
+	KeyCodeNextFavorite            = "KEY_NEXT_FAVORITE"
+	KeyCodeStopRecord              = "KEY_STOP_RECORD"
+	KeyCodePauseRecord             = "KEY_PAUSE_RECORD"
+	KeyCodeVod                     = "KEY_VOD"
+	KeyCodeUnmute                  = "KEY_UNMUTE"
+	KeyCodeFastreverse             = "KEY_FASTREVERSE"
+	KeyCodeSlowreverse             = "KEY_SLOWREVERSE"
+	KeyCodeData                    = "KEY_DATA"
+	KeyCodeOnscreenKeyboard        = "KEY_ONSCREEN_KEYBOARD"
+	KeyCodePrivacyScreenToggle     = "KEY_PRIVACY_SCREEN_TOGGLE"
+	KeyCodeSelectiveScreenshot     = "KEY_SELECTIVE_SCREENSHOT"
+	KeyCodeNextElement             = "KEY_NEXT_ELEMENT"
+	KeyCodePreviousElement         = "KEY_PREVIOUS_ELEMENT"
+	KeyCodeAutopilotEngageToggle   = "KEY_AUTOPILOT_ENGAGE_TOGGLE"
+	KeyCodeMarkWaypoint            = "KEY_MARK_WAYPOINT"
+	KeyCodeSos                     = "KEY_SOS"
+	KeyCodeNavChart                = "KEY_NAV_CHART"
+	KeyCodeFishingChart            = "KEY_FISHING_CHART"
+	KeyCodeSingleRangeRadar        = "KEY_SINGLE_RANGE_RADAR"
+	KeyCodeDualRangeRadar          = "KEY_DUAL_RANGE_RADAR"
+	KeyCodeRadarOverlay            = "KEY_RADAR_OVERLAY"
+	KeyCodeTraditionalSonar        = "KEY_TRADITIONAL_SONAR"
+	KeyCodeClearvuSonar            = "KEY_CLEARVU_SONAR"
+	KeyCodeSidevuSonar             = "KEY_SIDEVU_SONAR"
+	KeyCodeNavInfo                 = "KEY_NAV_INFO"
+	KeyCodeBrightnessMenu          = "KEY_BRIGHTNESS_MENU"
+	KeyCodeMacro1                  = "KEY_MACRO1"
+	KeyCodeMacro2                  = "KEY_MACRO2"
+	KeyCodeMacro3                  = "KEY_MACRO3"
+	KeyCodeMacro4                  = "KEY_MACRO4"
+	KeyCodeMacro5                  = "KEY_MACRO5"
+	KeyCodeMacro6                  = "KEY_MACRO6"
+	KeyCodeMacro7                  = "KEY_MACRO7"
+	KeyCodeMacro8                  = "KEY_MACRO8"
+	KeyCodeMacro9                  = "KEY_MACRO9"
+	KeyCodeMacro10                 = "KEY_MACRO10"
+	KeyCodeMacro11                 = "KEY_MACRO11"
+	KeyCodeMacro12                 = "KEY_MACRO12"
+	KeyCodeMacro13                 = "KEY_MACRO13"
+	KeyCodeMacro14                 = "KEY_MACRO14"
+	KeyCodeMacro15                 = "KEY_MACRO15"
+	KeyCodeMacro16                 = "KEY_MACRO16"
+	KeyCodeMacro17                 = "KEY_MACRO17"
+	KeyCodeMacro18                 = "KEY_MACRO18"
+	KeyCodeMacro19                 = "KEY_MACRO19"
+	KeyCodeMacro20                 = "KEY_MACRO20"
+	KeyCodeMacro21                 = "KEY_MACRO21"
+	KeyCodeMacro22                 = "KEY_MACRO22"
+	KeyCodeMacro23                 = "KEY_MACRO23"
+	KeyCodeMacro24                 = "KEY_MACRO24"
+	KeyCodeMacro25                 = "KEY_MACRO25"
+	KeyCodeMacro26                 = "KEY_MACRO26"
+	KeyCodeMacro27                 = "KEY_MACRO27"
+	KeyCodeMacro28                 = "KEY_MACRO28"
+	KeyCodeMacro29                 = "KEY_MACRO29"
+	KeyCodeMacro30                 = "KEY_MACRO30"
+	KeyCodeMacroRecordStart        = "KEY_MACRO_RECORD_START"
+	KeyCodeMacroRecordStop         = "KEY_MACRO_RECORD_STOP"
+	KeyCodeMacroPresetCycle        = "KEY_MACRO_PRESET_CYCLE"
+	KeyCodeMacroPreset1            = "KEY_MACRO_PRESET1"
+	KeyCodeMacroPreset2            = "KEY_MACRO_PRESET2"
+	KeyCodeMacroPreset3            = "KEY_MACRO_PRESET3"
+	KeyCodeKbdLcdMenu1             = "KEY_KBD_LCD_MENU1"
+	KeyCodeKbdLcdMenu2             = "KEY_KBD_LCD_MENU2"
+	KeyCodeKbdLcdMenu3             = "KEY_KBD_LCD_MENU3"
+	KeyCodeKbdLcdMenu4             = "KEY_KBD_LCD_MENU4"
+	KeyCodeKbdLcdMenu5             = "KEY_KBD_LCD_MENU5"
+	KeyCodeBtnTriggerHappy         = "BTN_TRIGGER_HAPPY"
+	KeyCodeBtnTriggerHappy1        = "BTN_TRIGGER_HAPPY1"
+	KeyCodeBtnTriggerHappy2        = "BTN_TRIGGER_HAPPY2"
+	KeyCodeBtnTriggerHappy3        = "BTN_TRIGGER_HAPPY3"
+	KeyCodeBtnTriggerHappy4        = "BTN_TRIGGER_HAPPY4"
+	KeyCodeBtnTriggerHappy5        = "BTN_TRIGGER_HAPPY5"
+	KeyCodeBtnTriggerHappy6        = "BTN_TRIGGER_HAPPY6"
+	KeyCodeBtnTriggerHappy7        = "BTN_TRIGGER_HAPPY7"
+	KeyCodeBtnTriggerHappy8        = "BTN_TRIGGER_HAPPY8"
+	KeyCodeBtnTriggerHappy9        = "BTN_TRIGGER_HAPPY9"
+	KeyCodeBtnTriggerHappy10       = "BTN_TRIGGER_HAPPY10"
+	KeyCodeBtnTriggerHappy11       = "BTN_TRIGGER_HAPPY11"
+	KeyCodeBtnTriggerHappy12       = "BTN_TRIGGER_HAPPY12"
+	KeyCodeBtnTriggerHappy13       = "BTN_TRIGGER_HAPPY13"
+	KeyCodeBtnTriggerHappy14       = "BTN_TRIGGER_HAPPY14"
+	KeyCodeBtnTriggerHappy15       = "BTN_TRIGGER_HAPPY15"
+	KeyCodeBtnTriggerHappy16       = "BTN_TRIGGER_HAPPY16"
+	KeyCodeBtnTriggerHappy17       = "BTN_TRIGGER_HAPPY17"
+	KeyCodeBtnTriggerHappy18       = "BTN_TRIGGER_HAPPY18"
+	KeyCodeBtnTriggerHappy19       = "BTN_TRIGGER_HAPPY19"
+	KeyCodeBtnTriggerHappy20       = "BTN_TRIGGER_HAPPY20"
+	KeyCodeBtnTriggerHappy21       = "BTN_TRIGGER_HAPPY21"
+	KeyCodeBtnTriggerHappy22       = "BTN_TRIGGER_HAPPY22"
+	KeyCodeBtnTriggerHappy23       = "BTN_TRIGGER_HAPPY23"
+	KeyCodeBtnTriggerHappy24       = "BTN_TRIGGER_HAPPY24"
+	KeyCodeBtnTriggerHappy25       = "BTN_TRIGGER_HAPPY25"
+	KeyCodeBtnTriggerHappy26       = "BTN_TRIGGER_HAPPY26"
+	KeyCodeBtnTriggerHappy27       = "BTN_TRIGGER_HAPPY27"
+	KeyCodeBtnTriggerHappy28       = "BTN_TRIGGER_HAPPY28"
+	KeyCodeBtnTriggerHappy29       = "BTN_TRIGGER_HAPPY29"
+	KeyCodeBtnTriggerHappy30       = "BTN_TRIGGER_HAPPY30"
+	KeyCodeBtnTriggerHappy31       = "BTN_TRIGGER_HAPPY31"
+	KeyCodeBtnTriggerHappy32       = "BTN_TRIGGER_HAPPY32"
+	KeyCodeBtnTriggerHappy33       = "BTN_TRIGGER_HAPPY33"
+	KeyCodeBtnTriggerHappy34       = "BTN_TRIGGER_HAPPY34"
+	KeyCodeBtnTriggerHappy35       = "BTN_TRIGGER_HAPPY35"
+	KeyCodeBtnTriggerHappy36       = "BTN_TRIGGER_HAPPY36"
+	KeyCodeBtnTriggerHappy37       = "BTN_TRIGGER_HAPPY37"
+	KeyCodeBtnTriggerHappy38       = "BTN_TRIGGER_HAPPY38"
+	KeyCodeBtnTriggerHappy39       = "BTN_TRIGGER_HAPPY39"
+	KeyCodeBtnTriggerHappy40       = "BTN_TRIGGER_HAPPY40"
+)
+
+// keyCodes maps the evdev key code names to their codes.
+var keyCodes = map[string]uint16{
+	"KEY_RESERVED":                 0,
+	"KEY_ESC":                      1,
+	"KEY_1":                        2,
+	"KEY_2":                        3,
+	"KEY_3":                        4,
+	"KEY_4":                        5,
+	"KEY_5":                        6,
+	"KEY_6":                        7,
+	"KEY_7":                        8,
+	"KEY_8":                        9,
+	"KEY_9":                        10,
+	"KEY_0":                        11,
+	"KEY_MINUS":                    12,
+	"KEY_EQUAL":                    13,
+	"KEY_BACKSPACE":                14,
+	"KEY_TAB":                      15,
+	"KEY_Q":                        16,
+	"KEY_W":                        17,
+	"KEY_E":                        18,
+	"KEY_R":                        19,
+	"KEY_T":                        20,
+	"KEY_Y":                        21,
+	"KEY_U":                        22,
+	"KEY_I":                        23,
+	"KEY_O":                        24,
+	"KEY_P":                        25,
+	"KEY_LEFTBRACE":                26,
+	"KEY_RIGHTBRACE":               27,
+	"KEY_ENTER":                    28,
+	"KEY_LEFTCTRL":                 29,
+	"KEY_A":                        30,
+	"KEY_S":                        31,
+	"KEY_D":                        32,
+	"KEY_F":                        33,
+	"KEY_G":                        34,
+	"KEY_H":                        35,
+	"KEY_J":                        36,
+	"KEY_K":                        37,
+	"KEY_L":                        38,
+	"KEY_SEMICOLON":                39,
+	"KEY_APOSTROPHE":               40,
+	"KEY_GRAVE":                    41,
+	"KEY_LEFTSHIFT":                42,
+	"KEY_BACKSLASH":                43,
+	"KEY_Z":                        44,
+	"KEY_X":                        45,
+	"KEY_C":                        46,
+	"KEY_V":                        47,
+	"KEY_B":                        48,
+	"KEY_N":                        49,
+	"KEY_M":                        50,
+	"KEY_COMMA":                    51,
+	"KEY_DOT":                      52,
+	"KEY_SLASH":                    53,
+	"KEY_RIGHTSHIFT":               54,
+	"KEY_KPASTERISK":               55,
+	"KEY_LEFTALT":                  56,
+	"KEY_SPACE":                    57,
+	"KEY_CAPSLOCK":                 58,
+	"KEY_F1":                       59,
+	"KEY_F2":                       60,
+	"KEY_F3":                       61,
+	"KEY_F4":                       62,
+	"KEY_F5":                       63,
+	"KEY_F6":                       64,
+	"KEY_F7":                       65,
+	"KEY_F8":                       66,
+	"KEY_F9":                       67,
+	"KEY_F10":                      68,
+	"KEY_NUMLOCK":                  69,
+	"KEY_SCROLLLOCK":               70,
+	"KEY_KP7":                      71,
+	"KEY_KP8":                      72,
+	"KEY_KP9":                      73,
+	"KEY_KPMINUS":                  74,
+	"KEY_KP4":                      75,
+	"KEY_KP5":                      76,
+	"KEY_KP6":                      77,
+	"KEY_KPPLUS":                   78,
+	"KEY_KP1":                      79,
+	"KEY_KP2":                      80,
+	"KEY_KP3":                      81,
+	"KEY_KP0":                      82,
+	"KEY_KPDOT":                    83,
+	"KEY_ZENKAKUHANKAKU":           85,
+	"KEY_102ND":                    86,
+	"KEY_F11":                      87,
+	"KEY_F12":                      88,
+	"KEY_RO":                       89,
+	"KEY_KATAKANA":                 90,
+	"KEY_HIRAGANA":                 91,
+	"KEY_HENKAN":                   92,
+	"KEY_KATAKANAHIRAGANA":         93,
+	"KEY_MUHENKAN":                 94,
+	"KEY_KPJPCOMMA":                95,
+	"KEY_KPENTER":                  96,
+	"KEY_RIGHTCTRL":                97,
+	"KEY_KPSLASH":                  98,
+	"KEY_SYSRQ":                    99,
+	"KEY_RIGHTALT":                 100,
+	"KEY_LINEFEED":                 101,
+	"KEY_HOME":                     102,
+	"KEY_UP":                       103,
+	"KEY_PAGEUP":                   104,
+	"KEY_LEFT":                     105,
+	"KEY_RIGHT":                    106,
+	"KEY_END":                      107,
+	"KEY_DOWN":                     108,
+	"KEY_PAGEDOWN":                 109,
+	"KEY_INSERT":                   110,
+	"KEY_DELETE":                   111,
+	"KEY_MACRO":                    112,
+	"KEY_MIN_INTERESTING":          113,
+	"KEY_MUTE":                     113,
+	"KEY_VOLUMEDOWN":               114,
+	"KEY_VOLUMEUP":                 115,
+	"KEY_POWER":                    116,
+	"KEY_KPEQUAL":                  117,
+	"KEY_KPPLUSMINUS":              118,
+	"KEY_PAUSE":                    119,
+	"KEY_SCALE":                    120,
+	"KEY_KPCOMMA":                  121,
+	"KEY_HANGEUL":                  122,
+	"KEY_HANGUEL":                  122,
+	"KEY_HANJA":                    123,
+	"KEY_YEN":                      124,
+	"KEY_LEFTMETA":                 125,
+	"KEY_RIGHTMETA":                126,
+	"KEY_COMPOSE":                  127,
+	"KEY_STOP":                     128,
+	"KEY_AGAIN":                    129,
+	"KEY_PROPS":                    130,
+	"KEY_UNDO":                     131,
+	"KEY_FRONT":                    132,
+	"KEY_COPY":                     133,
+	"KEY_OPEN":                     134,
+	"KEY_PASTE":                    135,
+	"KEY_FIND":                     136,
+	"KEY_CUT":                      137,
+	"KEY_HELP":                     138,
+	"KEY_MENU":                     139,
+	"KEY_CALC":                     140,
+	"KEY_SETUP":                    141,
+	"KEY_SLEEP":                    142,
+	"KEY_WAKEUP":                   143,
+	"KEY_FILE":                     144,
+	"KEY_SENDFILE":                 145,
+	"KEY_DELETEFILE":               146,
+	"KEY_XFER":                     147,
+	"KEY_PROG1":                    148,
+	"KEY_PROG2":                    149,
+	"KEY_WWW":                      150,
+	"KEY_MSDOS":                    151,
+	"KEY_COFFEE":                   152,
+	"KEY_SCREENLOCK":               152,
+	"KEY_DIRECTION":                153,
+	"KEY_ROTATE_DISPLAY":           153,
+	"KEY_CYCLEWINDOWS":             154,
+	"KEY_MAIL":                     155,
+	"KEY_BOOKMARKS":                156,
+	"KEY_COMPUTER":                 157,
+	"KEY_BACK":                     158,
+	"KEY_FORWARD":                  159,
+	"KEY_CLOSECD":                  160,
+	"KEY_EJECTCD":                  161,
+	"KEY_EJECTCLOSECD":             162,
+	"KEY_NEXTSONG":                 163,
+	"KEY_PLAYPAUSE":                164,
+	"KEY_PREVIOUSSONG":             165,
+	"KEY_STOPCD":                   166,
+	"KEY_RECORD":                   167,
+	"KEY_REWIND":                   168,
+	"KEY_PHONE":                    169,
+	"KEY_ISO":                      170,
+	"KEY_CONFIG":                   171,
+	"KEY_HOMEPAGE":                 172,
+	"KEY_REFRESH":                  173,
+	"KEY_EXIT":                     174,
+	"KEY_MOVE":                     175,
+	"KEY_EDIT":                     176,
+	"KEY_SCROLLUP":                 177,
+	"KEY_SCROLLDOWN":               178,
+	"KEY_KPLEFTPAREN":              179,
+	"KEY_KPRIGHTPAREN":             180,
+	"KEY_NEW":                      181,
+	"KEY_REDO":                     182,
+	"KEY_F13":                      183,
+	"KEY_F14":                      184,
+	"KEY_F15":                      185,
+	"KEY_F16":                      186,
+	"KEY_F17":                      187,
+	"KEY_F18":                      188,
+	"KEY_F19":                      189,
+	"KEY_F20":                      190,
+	"KEY_F21":                      191,
+	"KEY_F22":                      192,
+	"KEY_F23":                      193,
+	"KEY_F24":                      194,
+	"KEY_PLAYCD":                   200,
+	"KEY_PAUSECD":                  201,
+	"KEY_PROG3":                    202,
+	"KEY_PROG4":                    203,
+	"KEY_ALL_APPLICATIONS":         204,
+	"KEY_DASHBOARD":                204,
+	"KEY_SUSPEND":                  205,
+	"KEY_CLOSE":                    206,
+	"KEY_PLAY":                     207,
+	"KEY_FASTFORWARD":              208,
+	"KEY_BASSBOOST":                209,
+	"KEY_PRINT":                    210,
+	"KEY_HP":                       211,
+	"KEY_CAMERA":                   212,
+	"KEY_SOUND":                    213,
+	"KEY_QUESTION":                 214,
+	"KEY_EMAIL":                    215,
+	"KEY_CHAT":                     216,
+	"KEY_SEARCH":                   217,
+	"KEY_CONNECT":                  218,
+	"KEY_FINANCE":                  219,
+	"KEY_SPORT":                    220,
+	"KEY_SHOP":                     221,
+	"KEY_ALTERASE":                 222,
+	"KEY_CANCEL":                   223,
+	"KEY_BRIGHTNESSDOWN":           224,
+	"KEY_BRIGHTNESSUP":             225,
+	"KEY_MEDIA":                    226,
+	"KEY_SWITCHVIDEOMODE":          227,
+	"KEY_KBDILLUMTOGGLE":           228,
+	"KEY_KBDILLUMDOWN":             229,
+	"KEY_KBDILLUMUP":               230,
+	"KEY_SEND":                     231,
+	"KEY_REPLY":                    232,
+	"KEY_FORWARDMAIL":              233,
+	"KEY_SAVE":                     234,
+	"KEY_DOCUMENTS":                235,
+	"KEY_BATTERY":                  236,
+	"KEY_BLUETOOTH":                237,
+	"KEY_WLAN":                     238,
+	"KEY_UWB":                      239,
+	"KEY_UNKNOWN":                  240,
+	"KEY_VIDEO_NEXT":               241,
+	"KEY_VIDEO_PREV":               242,
+	"KEY_BRIGHTNESS_CYCLE":         243,
+	"KEY_BRIGHTNESS_AUTO":          244,
+	"KEY_BRIGHTNESS_ZERO":          244,
+	"KEY_DISPLAY_OFF":              245,
+	"KEY_WIMAX":                    246,
+	"KEY_WWAN":                     246,
+	"KEY_RFKILL":                   247,
+	"KEY_MICMUTE":                  248,
+	"BTN_0":                        256,
+	"BTN_MISC":                     256,
+	"BTN_1":                        257,
+	"BTN_2":                        258,
+	"BTN_3":                        259,
+	"BTN_4":                        260,
+	"BTN_5":                        261,
+	"BTN_6":                        262,
+	"BTN_7":                        263,
+	"BTN_8":                        264,
+	"BTN_9":                        265,
+	"BTN_LEFT":                     272,
+	"BTN_MOUSE":                    272,
+	"BTN_RIGHT":                    273,
+	"BTN_MIDDLE":                   274,
+	"BTN_SIDE":                     275,
+	"BTN_EXTRA":                    276,
+	"BTN_FORWARD":                  277,
+	"BTN_BACK":                     278,
+	"BTN_TASK":                     279,
+	"BTN_JOYSTICK":                 288,
+	"BTN_TRIGGER":                  288,
+	"BTN_THUMB":                    289,
+	"BTN_THUMB2":                   290,
+	"BTN_TOP":                      291,
+	"BTN_TOP2":                     292,
+	"BTN_PINKIE":                   293,
+	"BTN_BASE":                     294,
+	"BTN_BASE2":                    295,
+	"BTN_BASE3":                    296,
+	"BTN_BASE4":                    297,
+	"BTN_BASE5":                    298,
+	"BTN_BASE6":                    299,
+	"BTN_DEAD":                     303,
+	"BTN_A":                        304,
+	"BTN_GAMEPAD":                  304,
+	"BTN_SOUTH":                    304,
+	"BTN_B":                        305,
+	"BTN_EAST":                     305,
+	"BTN_C":                        306,
+	"BTN_NORTH":                    307,
+	"BTN_X":                        307,
+	"BTN_WEST":                     308,
+	"BTN_Y":                        308,
+	"BTN_Z":                        309,
+	"BTN_TL":                       310,
+	"BTN_TR":                       311,
+	"BTN_TL2":                      312,
+	"BTN_TR2":                      313,
+	"BTN_SELECT":                   314,
+	"BTN_START":                    315,
+	"BTN_MODE":                     316,
+	"BTN_THUMBL":                   317,
+	"BTN_THUMBR":                   318,
+	"BTN_DIGI":                     320,
+	"BTN_TOOL_PEN":                 320,
+	"BTN_TOOL_RUBBER":              321,
+	"BTN_TOOL_BRUSH":               322,
+	"BTN_TOOL_PENCIL":              323,
+	"BTN_TOOL_AIRBRUSH":            324,
+	"BTN_TOOL_FINGER":              325,
+	"BTN_TOOL_MOUSE":               326,
+	"BTN_TOOL_LENS":                327,
+	"BTN_TOOL_QUINTTAP":            328,
+	"BTN_STYLUS3":                  329,
+	"BTN_TOUCH":                    330,
+	"BTN_STYLUS":                   331,
+	"BTN_STYLUS2":                  332,
+	"BTN_TOOL_DOUBLETAP":           333,
+	"BTN_TOOL_TRIPLETAP":           334,
+	"BTN_TOOL_QUADTAP":             335,
+	"BTN_GEAR_DOWN":                336,
+	"BTN_WHEEL":                    336,
+	"BTN_GEAR_UP":                  337,
+	"KEY_OK":                       352,
+	"KEY_SELECT":                   353,
+	"KEY_GOTO":                     354,
+	"KEY_CLEAR":                    355,
+	"KEY_POWER2":                   356,
+	"KEY_OPTION":                   357,
+	"KEY_INFO":                     358,
+	"KEY_TIME":                     359,
+	"KEY_VENDOR":                   360,
+	"KEY_ARCHIVE":                  361,
+	"KEY_PROGRAM":                  362,
+	"KEY_CHANNEL":                  363,
+	"KEY_FAVORITES":                364,
+	"KEY_EPG":                      365,
+	"KEY_PVR":                      366,
+	"KEY_MHP":                      367,
+	"KEY_LANGUAGE":                 368,
+	"KEY_TITLE":                    369,
+	"KEY_SUBTITLE":                 370,
+	"KEY_ANGLE":                    371,
+	"KEY_FULL_SCREEN":              372,
+	"KEY_ZOOM":                     372,
+	"KEY_MODE":                     373,
+	"KEY_KEYBOARD":                 374,
+	"KEY_ASPECT_RATIO":             375,
+	"KEY_SCREEN":                   375,
+	"KEY_PC":                       376,
+	"KEY_TV":                       377,
+	"KEY_TV2":                      378,
+	"KEY_VCR":                      379,
+	"KEY_VCR2":                     380,
+	"KEY_SAT":                      381,
+	"KEY_SAT2":                     382,
+	"KEY_CD":                       383,
+	"KEY_TAPE":                     384,
+	"KEY_RADIO":                    385,
+	"KEY_TUNER":                    386,
+	"KEY_PLAYER":                   387,
+	"KEY_TEXT":                     388,
+	"KEY_DVD":                      389,
+	"KEY_AUX":                      390,
+	"KEY_MP3":                      391,
+	"KEY_AUDIO":                    392,
+	"KEY_VIDEO":                    393,
+	"KEY_DIRECTORY":                394,
+	"KEY_LIST":                     395,
+	"KEY_MEMO":                     396,
+	"KEY_CALENDAR":                 397,
+	"KEY_RED":                      398,
+	"KEY_GREEN":                    399,
+	"KEY_YELLOW":                   400,
+	"KEY_BLUE":                     401,
+	"KEY_CHANNELUP":                402,
+	"KEY_CHANNELDOWN":              403,
+	"KEY_FIRST":                    404,
+	"KEY_LAST":                     405,
+	"KEY_AB":                       406,
+	"KEY_NEXT":                     407,
+	"KEY_RESTART":                  408,
+	"KEY_SLOW":                     409,
+	"KEY_SHUFFLE":                  410,
+	"KEY_BREAK":                    411,
+	"KEY_PREVIOUS":                 412,
+	"KEY_DIGITS":                   413,
+	"KEY_TEEN":                     414,
+	"KEY_TWEN":                     415,
+	"KEY_VIDEOPHONE":               416,
+	"KEY_GAMES":                    417,
+	"KEY_ZOOMIN":                   418,
+	"KEY_ZOOMOUT":                  419,
+	"KEY_ZOOMRESET":                420,
+	"KEY_WORDPROCESSOR":            421,
+	"KEY_EDITOR":                   422,
+	"KEY_SPREADSHEET":              423,
+	"KEY_GRAPHICSEDITOR":           424,
+	"KEY_PRESENTATION":             425,
+	"KEY_DATABASE":                 426,
+	"KEY_NEWS":                     427,
+	"KEY_VOICEMAIL":                428,
+	"KEY_ADDRESSBOOK":              429,
+	"KEY_MESSENGER":                430,
+	"KEY_BRIGHTNESS_TOGGLE":        431,
+	"KEY_DISPLAYTOGGLE":            431,
+	"KEY_SPELLCHECK":               432,
+	"KEY_LOGOFF":                   433,
+	"KEY_DOLLAR":                   434,
+	"KEY_EURO":                     435,
+	"KEY_FRAMEBACK":                436,
+	"KEY_FRAMEFORWARD":             437,
+	"KEY_CONTEXT_MENU":             438,
+	"KEY_MEDIA_REPEAT":             439,
+	"KEY_10CHANNELSUP":             440,
+	"KEY_10CHANNELSDOWN":           441,
+	"KEY_IMAGES":                   442,
+	"KEY_NOTIFICATION_CENTER":      444,
+	"KEY_PICKUP_PHONE":             445,
+	"KEY_HANGUP_PHONE":             446,
+	"KEY_DEL_EOL":                  448,
+	"KEY_DEL_EOS":                  449,
+	"KEY_INS_LINE":                 450,
+	"KEY_DEL_LINE":                 451,
+	"KEY_FN":                       464,
+	"KEY_FN_ESC":                   465,
+	"KEY_FN_F1":                    466,
+	"KEY_FN_F2":                    467,
+	"KEY_FN_F3":                    468,
+	"KEY_FN_F4":                    469,
+	"KEY_FN_F5":                    470,
+	"KEY_FN_F6":                    471,
+	"KEY_FN_F7":                    472,
+	"KEY_FN_F8":                    473,
+	"KEY_FN_F9":                    474,
+	"KEY_FN_F10":                   475,
+	"KEY_FN_F11":                   476,
+	"KEY_FN_F12":                   477,
+	"KEY_FN_1":                     478,
+	"KEY_FN_2":                     479,
+	"KEY_FN_D":                     480,
+	"KEY_FN_E":                     481,
+	"KEY_FN_F":                     482,
+	"KEY_FN_S":                     483,
+	"KEY_FN_B":                     484,
+	"KEY_FN_RIGHT_SHIFT":           485,
+	"KEY_BRL_DOT1":                 497,
+	"KEY_BRL_DOT2":                 498,
+	"KEY_BRL_DOT3":                 499,
+	"KEY_BRL_DOT4":                 500,
+	"KEY_BRL_DOT5":                 501,
+	"KEY_BRL_DOT6":                 502,
+	"KEY_BRL_DOT7":                 503,
+	"KEY_BRL_DOT8":                 504,
+	"KEY_BRL_DOT9":                 505,
+	"KEY_BRL_DOT10":                506,
+	"KEY_NUMERIC_0":                512,
+	"KEY_NUMERIC_1":                513,
+	"KEY_NUMERIC_2":                514,
+	"KEY_NUMERIC_3":                515,
+	"KEY_NUMERIC_4":                516,
+	"KEY_NUMERIC_5":                517,
+	"KEY_NUMERIC_6":                518,
+	"KEY_NUMERIC_7":                519,
+	"KEY_NUMERIC_8":                520,
+	"KEY_NUMERIC_9":                521,
+	"KEY_NUMERIC_STAR":             522,
+	"KEY_NUMERIC_POUND":            523,
+	"KEY_NUMERIC_A":                524,
+	"KEY_NUMERIC_B":                525,
+	"KEY_NUMERIC_C":                526,
+	"KEY_NUMERIC_D":                527,
+	"KEY_CAMERA_FOCUS":             528,
+	"KEY_WPS_BUTTON":               529,
+	"KEY_TOUCHPAD_TOGGLE":          530,
+	"KEY_TOUCHPAD_ON":              531,
+	"KEY_TOUCHPAD_OFF":             532,
+	"KEY_CAMERA_ZOOMIN":            533,
+	"KEY_CAMERA_ZOOMOUT":           534,
+	"KEY_CAMERA_UP":                535,
+	"KEY_CAMERA_DOWN":              536,
+	"KEY_CAMERA_LEFT":              537,
+	"KEY_CAMERA_RIGHT":             538,
+	"KEY_ATTENDANT_ON":             539,
+	"KEY_ATTENDANT_OFF":            540,
+	"KEY_ATTENDANT_TOGGLE":         541,
+	"KEY_LIGHTS_TOGGLE":            542,
+	"BTN_DPAD_UP":                  544,
+	"BTN_DPAD_DOWN":                545,
+	"BTN_DPAD_LEFT":                546,
+	"BTN_DPAD_RIGHT":               547,
+	"KEY_ALS_TOGGLE":               560,
+	"KEY_ROTATE_LOCK_TOGGLE":       561,
+	"KEY_BUTTONCONFIG":             576,
+	"KEY_TASKMANAGER":              577,
+	"KEY_JOURNAL":                  578,
+	"KEY_CONTROLPANEL":             579,
+	"KEY_APPSELECT":                580,
+	"KEY_SCREENSAVER":              581,
+	"KEY_VOICECOMMAND":             582,
+	"KEY_ASSISTANT":                583,
+	"KEY_KBD_LAYOUT_NEXT":          584,
+	"KEY_EMOJI_PICKER":             585,
+	"KEY_DICTATE":                  586,
+	"KEY_CAMERA_ACCESS_ENABLE":     587,
+	"KEY_CAMERA_ACCESS_DISABLE":    588,
+	"KEY_CAMERA_ACCESS_TOGGLE":     589,
+	"KEY_BRIGHTNESS_MIN":           592,
+	"KEY_BRIGHTNESS_MAX":           593,
+	"KEY_KBDINPUTASSIST_PREV":      608,
+	"KEY_KBDINPUTASSIST_NEXT":      609,
+	"KEY_KBDINPUTASSIST_PREVGROUP": 610,
+	"KEY_KBDINPUTASSIST_NEXTGROUP": 611,
+	"KEY_KBDINPUTASSIST_ACCEPT":    612,
+	"KEY_KBDINPUTASSIST_CANCEL":    613,
+	"KEY_RIGHT_UP":                 614,
+	"KEY_RIGHT_DOWN":               615,
+	"KEY_LEFT_UP":                  616,
+	"KEY_LEFT_DOWN":                617,
+	"KEY_ROOT_MENU":                618,
+	"KEY_MEDIA_TOP_MENU":           619,
+	"KEY_NUMERIC_11":               620,
+	"KEY_NUMERIC_12":               621,
+	"KEY_AUDIO_DESC":               622,
+	"KEY_3D_MODE":                  623,
+	"KEY_NEXT_FAVORITE":            624,
+	"KEY_STOP_RECORD":              625,
+	"KEY_PAUSE_RECORD":             626,
+	"KEY_VOD":                      627,
+	"KEY_UNMUTE":                   628,
+	"KEY_FASTREVERSE":              629,
+	"KEY_SLOWREVERSE":              630,
+	"KEY_DATA":                     631,
+	"KEY_ONSCREEN_KEYBOARD":        632,
+	"KEY_PRIVACY_SCREEN_TOGGLE":    633,
+	"KEY_SELECTIVE_SCREENSHOT":     634,
+	"KEY_NEXT_ELEMENT":             635,
+	"KEY_PREVIOUS_ELEMENT":         636,
+	"KEY_AUTOPILOT_ENGAGE_TOGGLE":  637,
+	"KEY_MARK_WAYPOINT":            638,
+	"KEY_SOS":                      639,
+	"KEY_NAV_CHART":                640,
+	"KEY_FISHING_CHART":            641,
+	"KEY_SINGLE_RANGE_RADAR":       642,
+	"KEY_DUAL_RANGE_RADAR":         643,
+	"KEY_RADAR_OVERLAY":            644,
+	"KEY_TRADITIONAL_SONAR":        645,
+	"KEY_CLEARVU_SONAR":            646,
+	"KEY_SIDEVU_SONAR":             647,
+	"KEY_NAV_INFO":                 648,
+	"KEY_BRIGHTNESS_MENU":          649,
+	"KEY_MACRO1":                   656,
+	"KEY_MACRO2":                   657,
+	"KEY_MACRO3":                   658,
+	"KEY_MACRO4":                   659,
+	"KEY_MACRO5":                   660,
+	"KEY_MACRO6":                   661,
+	"KEY_MACRO7":                   662,
+	"KEY_MACRO8":                   663,
+	"KEY_MACRO9":                   664,
+	"KEY_MACRO10":                  665,
+	"KEY_MACRO11":                  666,
+	"KEY_MACRO12":                  667,
+	"KEY_MACRO13":                  668,
+	"KEY_MACRO14":                  669,
+	"KEY_MACRO15":                  670,
+	"KEY_MACRO16":                  671,
+	"KEY_MACRO17":                  672,
+	"KEY_MACRO18":                  673,
+	"KEY_MACRO19":                  674,
+	"KEY_MACRO20":                  675,
+	"KEY_MACRO21":                  676,
+	"KEY_MACRO22":                  677,
+	"KEY_MACRO23":                  678,
+	"KEY_MACRO24":                  679,
+	"KEY_MACRO25":                  680,
+	"KEY_MACRO26":                  681,
+	"KEY_MACRO27":                  682,
+	"KEY_MACRO28":                  683,
+	"KEY_MACRO29":                  684,
+	"KEY_MACRO30":                  685,
+	"KEY_MACRO_RECORD_START":       688,
+	"KEY_MACRO_RECORD_STOP":        689,
+	"KEY_MACRO_PRESET_CYCLE":       690,
+	"KEY_MACRO_PRESET1":            691,
+	"KEY_MACRO_PRESET2":            692,
+	"KEY_MACRO_PRESET3":            693,
+	"KEY_KBD_LCD_MENU1":            696,
+	"KEY_KBD_LCD_MENU2":            697,
+	"KEY_KBD_LCD_MENU3":            698,
+	"KEY_KBD_LCD_MENU4":            699,
+	"KEY_KBD_LCD_MENU5":            700,
+	"BTN_TRIGGER_HAPPY":            704,
+	"BTN_TRIGGER_HAPPY1":           704,
+	"BTN_TRIGGER_HAPPY2":           705,
+	"BTN_TRIGGER_HAPPY3":           706,
+	"BTN_TRIGGER_HAPPY4":           707,
+	"BTN_TRIGGER_HAPPY5":           708,
+	"BTN_TRIGGER_HAPPY6":           709,
+	"BTN_TRIGGER_HAPPY7":           710,
+	"BTN_TRIGGER_HAPPY8":           711,
+	"BTN_TRIGGER_HAPPY9":           712,
+	"BTN_TRIGGER_HAPPY10":          713,
+	"BTN_TRIGGER_HAPPY11":          714,
+	"BTN_TRIGGER_HAPPY12":          715,
+	"BTN_TRIGGER_HAPPY13":          716,
+	"BTN_TRIGGER_HAPPY14":          717,
+	"BTN_TRIGGER_HAPPY15":          718,
+	"BTN_TRIGGER_HAPPY16":          719,
+	"BTN_TRIGGER_HAPPY17":          720,
+	"BTN_TRIGGER_HAPPY18":          721,
+	"BTN_TRIGGER_HAPPY19":          722,
+	"BTN_TRIGGER_HAPPY20":          723,
+	"BTN_TRIGGER_HAPPY21":          724,
+	"BTN_TRIGGER_HAPPY22":          725,
+	"BTN_TRIGGER_HAPPY23":          726,
+	"BTN_TRIGGER_HAPPY24":          727,
+	"BTN_TRIGGER_HAPPY25":          728,
+	"BTN_TRIGGER_HAPPY26":          729,
+	"BTN_TRIGGER_HAPPY27":          730,
+	"BTN_TRIGGER_HAPPY28":          731,
+	"BTN_TRIGGER_HAPPY29":          732,
+	"BTN_TRIGGER_HAPPY30":          733,
+	"BTN_TRIGGER_HAPPY31":          734,
+	"BTN_TRIGGER_HAPPY32":          735,
+	"BTN_TRIGGER_HAPPY33":          736,
+	"BTN_TRIGGER_HAPPY34":          737,
+	"BTN_TRIGGER_HAPPY35":          738,
+	"BTN_TRIGGER_HAPPY36":          739,
+	"BTN_TRIGGER_HAPPY37":          740,
+	"BTN_TRIGGER_HAPPY38":          741,
+	"BTN_TRIGGER_HAPPY39":          742,
+	"BTN_TRIGGER_HAPPY40":          743,
+}
+
+// axisNames is the set of evdev absolute axis code names.
+var axisNames = map[string]bool{
+	"ABS_X":              true,
+	"ABS_Y":              true,
+	"ABS_Z":              true,
+	"ABS_RX":             true,
+	"ABS_RY":             true,
+	"ABS_RZ":             true,
+	"ABS_THROTTLE":       true,
+	"ABS_RUDDER":         true,
+	"ABS_WHEEL":          true,
+	"ABS_GAS":            true,
+	"ABS_BRAKE":          true,
+	"ABS_HAT0X":          true,
+	"ABS_HAT0Y":          true,
+	"ABS_HAT1X":          true,
+	"ABS_HAT1Y":          true,
+	"ABS_HAT2X":          true,
+	"ABS_HAT2Y":          true,
+	"ABS_HAT3X":          true,
+	"ABS_HAT3Y":          true,
+	"ABS_PRESSURE":       true,
+	"ABS_DISTANCE":       true,
+	"ABS_TILT_X":         true,
+	"ABS_TILT_Y":         true,
+	"ABS_TOOL_WIDTH":     true,
+	"ABS_VOLUME":         true,
+	"ABS_PROFILE":        true,
+	"ABS_MISC":           true,
+	"ABS_RESERVED":       true,
+	"ABS_MT_SLOT":        true,
+	"ABS_MT_TOUCH_MAJOR": true,
+	"ABS_MT_TOUCH_MINOR": true,
+	"ABS_MT_WIDTH_MAJOR": true,
+	"ABS_MT_WIDTH_MINOR": true,
+	"ABS_MT_ORIENTATION": true,
+	"ABS_MT_POSITION_X":  true,
+	"ABS_MT_POSITION_Y":  true,
+	"ABS_MT_TOOL_TYPE":   true,
+	"ABS_MT_BLOB_ID":     true,
+	"ABS_MT_TRACKING_ID": true,
+	"ABS_MT_PRESSURE":    true,
+	"ABS_MT_DISTANCE":    true,
+	"ABS_MT_TOOL_X":      true,
+	"ABS_MT_TOOL_Y":      true,
+	"ABS_MAX":            true,
+	"ABS_CNT":            true,
+}
@@ -0,0 +1,31 @@
+package keyboard
+
+import (
+	"testing"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// TestKeyCodesMatchEvdev checks that keycodes.go is up to date with the
+// go-evdev tables gen_keycodes.go generates it from.
+func TestKeyCodesMatchEvdev(t *testing.T) {
+	for name, code := range evdev.KEYFromString {
+		if name == "KEY_MAX" || name == "KEY_CNT" {
+			continue
+		}
+		if got, ok := keyCodes[name]; !ok || got != uint16(code) {
+			t.Errorf("keyCodes[%q] = %d, %v, want %d; run go generate", name, got, ok, code)
+		}
+	}
+	if len(keyCodes) != len(evdev.KEYFromString)-2 {
+		t.Errorf("%d key codes, want %d; run go generate", len(keyCodes), len(evdev.KEYFromString)-2)
+	}
+	for name := range evdev.ABSFromString {
+		if !axisNames[name] {
+			t.Errorf("axis %q missing; run go generate", name)
+		}
+	}
+	if len(axisNames) != len(evdev.ABSFromString) {
+		t.Errorf("%d axis names, want %d; run go generate", len(axisNames), len(evdev.ABSFromString))
+	}
+}
@@ -0,0 +1,43 @@
+package keyboard
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"testing"
+)
+
+func TestKeyConstants(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "keycodes.go", nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			vs := spec.(*ast.ValueSpec)
+			name, err := strconv.Unquote(vs.Values[0].(*ast.BasicLit).Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			n++
+			if !IsKeyName(name) {
+				t.Errorf("%s = %q is not a key name", vs.Names[0].Name, name)
+			}
+			if _, ok := keyCodes[name]; !ok {
+				t.Errorf("%s = %q has no key code", vs.Names[0].Name, name)
+			}
+		}
+	}
+	if n != len(keyCodes) {
+		t.Errorf("%d constants for %d key codes", n, len(keyCodes))
+	}
+	if KeyCodeLeftCtrl != "KEY_LEFTCTRL" || KeyCodeA != "KEY_A" || KeyCodeBtnLeft != "BTN_LEFT" {
+		t.Errorf("KeyCodeLeftCtrl, KeyCodeA, KeyCodeBtnLeft = %q, %q, %q", KeyCodeLeftCtrl, KeyCodeA, KeyCodeBtnLeft)
+	}
+}
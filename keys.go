@@ -0,0 +1,245 @@
+package keyboard
+
+//go:generate go run gen_keycodes.go
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+var (
+	keyNamesOnce sync.Once
+	keyNames     map[string]bool // known evdev key code names
+)
+
+// loadKeyNames builds the set of known key code names from the key table.
+func loadKeyNames() {
+	keyNames = make(map[string]bool, len(keyCodes))
+	for name := range keyCodes {
+		keyNames[name] = true
+	}
+}
+
+// keyAliases maps alternative names to evdev key code names, for keys whose
+// evdev names are hard to guess, such as the numeric keypad's "KP" keys.
+var keyAliases = map[string]string{
+	"NUM0":        "KEY_KP0",
+	"NUM1":        "KEY_KP1",
+	"NUM2":        "KEY_KP2",
+	"NUM3":        "KEY_KP3",
+	"NUM4":        "KEY_KP4",
+	"NUM5":        "KEY_KP5",
+	"NUM6":        "KEY_KP6",
+	"NUM7":        "KEY_KP7",
+	"NUM8":        "KEY_KP8",
+	"NUM9":        "KEY_KP9",
+	"NUMPLUS":     "KEY_KPPLUS",
+	"NUMMINUS":    "KEY_KPMINUS",
+	"NUMASTERISK": "KEY_KPASTERISK",
+	"NUMMULTIPLY": "KEY_KPASTERISK",
+	"NUMSLASH":    "KEY_KPSLASH",
+	"NUMDIVIDE":   "KEY_KPSLASH",
+	"NUMDOT":      "KEY_KPDOT",
+	"NUMDECIMAL":  "KEY_KPDOT",
+	"NUMCOMMA":    "KEY_KPCOMMA",
+	"NUMEQUAL":    "KEY_KPEQUAL",
+	"NUMENTER":    "KEY_KPENTER",
+}
+
+// keyCode resolves s to its evdev key code name, accepting short forms
+// without the "KEY_" prefix (e.g., "A" resolves to "KEY_A"), the aliases in
+// keyAliases (e.g., "NUM0" resolves to "KEY_KP0"), the synthetic scroll
+// wheel keys (e.g., "REL_WHEEL_UP") and names of unknown key codes (e.g.,
+// "RAW_500"). It returns an empty string if s does not name a known key.
+func keyCode(s string) string {
+	keyNamesOnce.Do(loadKeyNames)
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if keyNames[s] || relKeyNames[s] {
+		return s
+	}
+	if _, ok := rawKeyCode(s); ok {
+		return s
+	}
+	if keyNames["KEY_"+s] {
+		return "KEY_" + s
+	}
+	return keyAliases[s]
+}
+
+// rawKeyPrefix starts the names of key codes evdev has no name for, which
+// ConvertEvent reports as e.g. "RAW_500".
+const rawKeyPrefix = "RAW_"
+
+// rawKeyCode returns the key code of a "RAW_<code>" key name.
+func rawKeyCode(key string) (uint16, bool) {
+	digits, ok := strings.CutPrefix(key, rawKeyPrefix)
+	if !ok {
+		return 0, false
+	}
+	code, err := strconv.ParseUint(digits, 10, 16)
+	return uint16(code), err == nil
+}
+
+// IsKeyName reports whether s is a known evdev key code (e.g., "KEY_A") or
+// one of the synthetic scroll wheel keys delivered with WithRelEvents.
+// Short forms such as "A" are accepted and resolve to "KEY_A", and numeric
+// keypad aliases such as "NUM0", "NUMPLUS" and "NUMENTER" resolve to
+// "KEY_KP0", "KEY_KPPLUS" and "KEY_KPENTER".
+func IsKeyName(s string) bool {
+	return keyCode(s) != ""
+}
+
+// modifierOrder lists the modifier names used in combo strings, in the
+// order they appear in normalized combos.
+var modifierOrder = []string{"CTRL", "SHIFT", "ALT", "META"}
+
+// isModifierName returns true if name is one of the modifier names used in
+// combo strings.
+func isModifierName(name string) bool {
+	return slices.Contains(modifierOrder, name)
+}
+
+// modifierRank returns the position of name in modifierOrder, placing
+// custom modifier names after the built-in ones.
+func modifierRank(name string) int {
+	if i := slices.Index(modifierOrder, name); i >= 0 {
+		return i
+	}
+	return len(modifierOrder)
+}
+
+// comboCache memoizes ParseCombo, mapping combo strings to their normalized
+// form. Only valid combos are stored, so it stays bounded by the set of
+// combos callers actually use and never needs eviction.
+var comboCache sync.Map
+
+// wildcard is the combo part matching any set of held modifiers.
+const wildcard = "*"
+
+// ParseCombo validates a combo string (e.g., "CTRL+ALT+T") and returns it in
+// the normalized form used to match bindings. Every part except the last must
+// be a modifier name (CTRL, SHIFT, ALT or META) or a non-modifier key held
+// before the last one (e.g., "A+B" for pressing B while A is held), and the
+// last part must be a known key name. Modifiers come first in the
+// normalized form, while other keys keep their order. A single "*" in place
+// of the modifiers (e.g., "*+A") matches the key regardless of which
+// modifiers are held.
+func ParseCombo(combo string) (string, error) {
+	if v, ok := comboCache.Load(combo); ok {
+		return v.(string), nil
+	}
+	norm, err := parseCombo(combo, isModifierName)
+	if err != nil {
+		return "", err
+	}
+	comboCache.Store(combo, norm)
+	return norm, nil
+}
+
+// parseCombo implements ParseCombo, accepting the modifier names for which
+// isMod returns true.
+func parseCombo(combo string, isMod func(string) bool) (string, error) {
+	parts := strings.Split(strings.ToUpper(combo), "+")
+	for i, p := range parts {
+		parts[i] = strings.TrimSpace(p)
+		if parts[i] == "" {
+			return "", fmt.Errorf("%w: empty key in combo %q", ErrKeyNameInvalid, combo)
+		}
+	}
+	last := len(parts) - 1
+	var mods, keys []string
+	for _, p := range parts[:last] {
+		if (p == wildcard && last == 1) || isMod(p) {
+			mods = append(mods, p)
+			continue
+		}
+		code := keyCode(p)
+		if code == "" {
+			return "", fmt.Errorf("%w: %q is not a modifier or key in combo %q", ErrKeyNameInvalid, p, combo)
+		}
+		if isModifier(code) {
+			return "", fmt.Errorf("%w: modifier key %q must be given as %s in combo %q", ErrKeyNameInvalid, p, modifierName(code), combo)
+		}
+		keys = append(keys, keyName(code))
+	}
+	code := keyCode(parts[last])
+	if code == "" {
+		return "", fmt.Errorf("%w %q in combo %q", ErrKeyNameInvalid, parts[last], combo)
+	}
+	keys = append(keys, keyName(code))
+	for i, k := range keys {
+		if slices.Contains(keys[:i], k) {
+			return "", fmt.Errorf("%w: key %q repeated in combo %q", ErrKeyNameInvalid, k, combo)
+		}
+	}
+	return formatCombo(sortModifiers(mods), strings.Join(keys, "+")), nil
+}
+
+// joinCombo builds the normalized combo string for the modifier names mods
+// and the trigger key name, which may be preceded by other held keys (e.g.,
+// "A+B"), for combos built from key events. It formats them like
+// parseCombo formats registered combos, so the two always agree. mods may
+// be reordered in place.
+func joinCombo(mods []string, trigger string) string {
+	combo := formatCombo(sortModifiers(mods), trigger)
+	if checkCombo != nil {
+		checkCombo(combo)
+	}
+	return combo
+}
+
+// checkCombo, if set, is called with every combo built by joinCombo. Tests
+// set it to assert that the combo is a fixed point of parseCombo.
+var checkCombo func(combo string)
+
+// formatCombo joins the modifier names uniq, sorted and deduplicated by
+// sortModifiers, and the trigger with "+". It is the single place where both
+// registered combos and combos built from key events are formatted.
+func formatCombo(uniq []string, trigger string) string {
+	if len(uniq) == 0 {
+		return trigger
+	}
+	// a single allocation for the result
+	n := len(trigger)
+	for _, mod := range uniq {
+		n += len(mod) + 1
+	}
+	var b strings.Builder
+	b.Grow(n)
+	for _, mod := range uniq {
+		b.WriteString(mod)
+		b.WriteByte('+')
+	}
+	b.WriteString(trigger)
+	return b.String()
+}
+
+// sortModifiers sorts the modifier names mods into modifierOrder, followed
+// by custom modifiers in alphabetical order, since keys pressed in any order
+// must produce the same combo. Duplicates such as CTRL from both the left
+// and right keys appear once. It reorders mods in place and returns the
+// deduplicated prefix.
+func sortModifiers(mods []string) []string {
+	slices.SortFunc(mods, func(a, b string) int {
+		if d := modifierRank(a) - modifierRank(b); d != 0 {
+			return d
+		}
+		return strings.Compare(a, b)
+	})
+	uniq := mods[:0]
+	for _, mod := range mods {
+		if !slices.Contains(uniq, mod) {
+			uniq = append(uniq, mod)
+		}
+	}
+	return uniq
+}
+
+// comboTrigger returns the non-modifier trigger key of a normalized combo.
+func comboTrigger(combo string) string {
+	return combo[strings.LastIndex(combo, "+")+1:]
+}
@@ -0,0 +1,73 @@
+package keyboard
+
+import (
+	"time"
+
+	"golang.org/x/exp/maps"
+)
+
+// KeyState is a snapshot of the keys held on a keyboard, for passing the
+// current state to other components (e.g., a UI showing held modifiers)
+// without exposing the Manager. The zero value has no keys held.
+type KeyState struct {
+	pressed   map[string]bool      // held keys
+	pressedAt map[string]time.Time // held keys to their last Press or Hold
+	mods      map[string]string    // held modifier keys to their modifier names
+}
+
+// KeyState returns a snapshot of the keys currently held.
+func (m *Manager) KeyState() KeyState {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s := KeyState{
+		pressed:   make(map[string]bool, m.keys.count()),
+		pressedAt: make(map[string]time.Time, m.keys.count()),
+		mods:      m.keys.modifierKeysHeld(),
+	}
+	m.keys.each(func(key string, at time.Time) {
+		s.pressed[key] = true
+		s.pressedAt[key] = at
+	})
+	return s
+}
+
+// IsPressed reports whether the key with the given code (e.g., "KEY_A") was
+// held.
+func (s KeyState) IsPressed(key string) bool {
+	return s.pressed[key]
+}
+
+// PressedAt returns when the key with the given code was last pressed or
+// reported as held, and false if it was not held.
+func (s KeyState) PressedAt(key string) (time.Time, bool) {
+	t, ok := s.pressedAt[key]
+	return t, ok
+}
+
+// Modifiers returns the names of the held modifiers (e.g., "CTRL") in the
+// order they appear in combos.
+func (s KeyState) Modifiers() []string {
+	mods := make([]string, 0, len(s.mods))
+	for _, name := range s.mods {
+		mods = append(mods, name)
+	}
+	return sortModifiers(mods)
+}
+
+// ActiveCombo returns the normalized combo formed by the held modifiers and
+// trigger, which may be a key code or short key name (e.g., "KEY_A" or "A").
+func (s KeyState) ActiveCombo(trigger string) string {
+	if code := keyCode(trigger); code != "" {
+		trigger = keyName(code)
+	}
+	return joinCombo(s.Modifiers(), trigger)
+}
+
+// Snapshot returns a copy of s that shares no state with it.
+func (s KeyState) Snapshot() KeyState {
+	return KeyState{
+		pressed:   maps.Clone(s.pressed),
+		pressedAt: maps.Clone(s.pressedAt),
+		mods:      maps.Clone(s.mods),
+	}
+}
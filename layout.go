@@ -0,0 +1,92 @@
+package keyboard
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Files read by DetectLayout, in order: the Debian-style keyboard
+// configuration and the X11 configuration written by localectl.
+const (
+	defaultKeyboardFile = "/etc/default/keyboard"
+	xorgKeyboardFile    = "/etc/X11/xorg.conf.d/00-keyboard.conf"
+)
+
+// DetectLayout returns the XKB keyboard layout (e.g., "us", "fr" or "de")
+// and variant (e.g., "dvorak" or "colemak", empty for the default) the
+// system is configured with. It reads the XKBLAYOUT and XKBVARIANT
+// environment variables, then /etc/default/keyboard, then the X11 keyboard
+// configuration written by localectl. If several layouts are configured
+// (e.g., "us,de"), the first one and its variant are returned. It returns
+// an error wrapping ErrLayoutUnknown if none of them set a layout.
+//
+// The layout cannot be read from the evdev device itself: the kernel only
+// reports key codes, and the layout is applied by XKB in user space.
+func DetectLayout() (layout, variant string, err error) {
+	if l := os.Getenv("XKBLAYOUT"); l != "" {
+		return firstLayout(l, os.Getenv("XKBVARIANT"))
+	}
+	for _, read := range []func() (map[string]string, error){readDefaultKeyboard, readXorgKeyboard} {
+		settings, err := read()
+		if err != nil && !os.IsNotExist(err) {
+			return "", "", err
+		}
+		if l := settings["XKBLAYOUT"]; l != "" {
+			return firstLayout(l, settings["XKBVARIANT"])
+		}
+	}
+	return "", "", fmt.Errorf("%w: XKBLAYOUT is not set in the environment, %s or %s", ErrLayoutUnknown, defaultKeyboardFile, xorgKeyboardFile)
+}
+
+// firstLayout returns the first entries of the comma-separated XKB layout
+// and variant lists.
+func firstLayout(layouts, variants string) (layout, variant string, err error) {
+	layout, _, _ = strings.Cut(layouts, ",")
+	variant, _, _ = strings.Cut(variants, ",")
+	return strings.TrimSpace(layout), strings.TrimSpace(variant), nil
+}
+
+// readDefaultKeyboard returns the shell variable assignments of
+// /etc/default/keyboard (e.g., XKBLAYOUT="us").
+func readDefaultKeyboard() (map[string]string, error) {
+	return readConfigLines(defaultKeyboardFile, func(line string) (string, string, bool) {
+		name, value, ok := strings.Cut(line, "=")
+		return strings.TrimSpace(name), strings.Trim(strings.TrimSpace(value), `"'`), ok
+	})
+}
+
+// readXorgKeyboard returns the XKB options of the X11 keyboard
+// configuration (e.g., Option "XkbLayout" "us"), with names in upper case.
+func readXorgKeyboard() (map[string]string, error) {
+	return readConfigLines(xorgKeyboardFile, func(line string) (string, string, bool) {
+		fields := strings.Fields(line)
+		if len(fields) != 3 || fields[0] != "Option" {
+			return "", "", false
+		}
+		return strings.ToUpper(strings.Trim(fields[1], `"`)), strings.Trim(fields[2], `"`), true
+	})
+}
+
+// readConfigLines reads the file at path and returns the name and value
+// pairs parse finds in its lines, skipping blank lines and # comments.
+func readConfigLines(path string, parse func(line string) (name, value string, ok bool)) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	settings := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if name, value, ok := parse(line); ok {
+			settings[name] = value
+		}
+	}
+	return settings, sc.Err()
+}
@@ -0,0 +1,16 @@
+package keyboard
+
+// ledState holds the tracked state of the keyboard lock LEDs.
+type ledState struct {
+	caps, num, scroll bool
+}
+
+// LEDState returns whether the CapsLock, NumLock and ScrollLock LEDs are on.
+// Managers created with NewManagerForDevice read the initial LED state from
+// the device and track EV_LED events afterwards; other Managers report all
+// LEDs as off.
+func (m *Manager) LEDState() (caps, num, scroll bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.leds.caps, m.leds.num, m.leds.scroll
+}
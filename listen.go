@@ -0,0 +1,123 @@
+package keyboard
+
+import (
+	"context"
+	"time"
+)
+
+// ListenOption configures the event stream returned by Listen.
+type ListenOption func(*listenConfig)
+
+// listenConfig holds the settings applied by ListenOptions.
+type listenConfig struct {
+	relEvents   bool            // also deliver mouse wheel events
+	dedupWindow time.Duration   // drop identical consecutive events closer than this
+	bufferSize  int             // capacity of the event channel
+	ctx         context.Context // stops listening once done
+	grab        bool            // grab the device for exclusive access
+	virtualName string          // name of the uinput device to forward events to
+	remap       *Manager        // handles events before they are forwarded
+	pollEvery   time.Duration   // interval at which ListenWait looks for a keyboard
+	startupMax  time.Duration   // how long ListenWait waits for a keyboard, 0 for no limit
+}
+
+func newListenConfig(opts []ListenOption) *listenConfig {
+	cfg := &listenConfig{ctx: context.Background(), pollEvery: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// WithRelEvents makes Listen also deliver scroll wheel (EV_REL) events of
+// the device. Every wheel step is sent as a Press immediately followed by a
+// Release of one of the synthetic keys REL_WHEEL_UP, REL_WHEEL_DOWN,
+// REL_HWHEEL_LEFT or REL_HWHEEL_RIGHT, so they can be bound like any other
+// key (e.g., "CTRL+REL_WHEEL_UP").
+func WithRelEvents() ListenOption {
+	return func(c *listenConfig) { c.relEvents = true }
+}
+
+// WithDeduplicate makes Listen drop an event if it has the same Key and Type
+// as the previous event and arrived less than window after it, filtering
+// out duplicate events caused by contact bounce. Unlike filtering in the
+// Manager, this applies uniformly to the stream before it is delivered.
+func WithDeduplicate(window time.Duration) ListenOption {
+	return func(c *listenConfig) { c.dedupWindow = window }
+}
+
+// WithBufferSize sets the capacity of the event channel returned by Listen,
+// letting the reading goroutine run ahead of a slow consumer by up to n
+// events. By default the channel is unbuffered.
+func WithBufferSize(n int) ListenOption {
+	return func(c *listenConfig) { c.bufferSize = max(n, 0) }
+}
+
+// WithContext makes Listen stop once ctx is done: the device is closed and
+// the event channel is closed without a Disconnect event.
+func WithContext(ctx context.Context) ListenOption {
+	return func(c *listenConfig) {
+		if ctx != nil {
+			c.ctx = ctx
+		}
+	}
+}
+
+// WithGrab makes Listen grab the keyboard for exclusive access, so that its
+// events are delivered only to the returned channel and not to other
+// readers such as the display server, until the device is closed.
+func WithGrab() ListenOption {
+	return func(c *listenConfig) { c.grab = true }
+}
+
+// DefaultPollInterval is the interval at which ListenWait looks for a
+// keyboard unless set with WithPollInterval.
+const DefaultPollInterval = time.Second
+
+// WithPollInterval sets the interval at which ListenWait looks for a
+// keyboard. Non-positive values keep DefaultPollInterval.
+func WithPollInterval(d time.Duration) ListenOption {
+	return func(c *listenConfig) {
+		if d > 0 {
+			c.pollEvery = d
+		}
+	}
+}
+
+// WithStartupTimeout limits how long ListenWait waits for a keyboard to
+// appear, after which it fails with ErrNoKeyboardFoundWithinTimeout. By
+// default it waits until its context is done.
+func WithStartupTimeout(d time.Duration) ListenOption {
+	return func(c *listenConfig) { c.startupMax = max(d, 0) }
+}
+
+// deduplicator detects consecutive identical events within a time window.
+type deduplicator struct {
+	window time.Duration
+	last   Event
+	lastAt time.Time
+}
+
+// duplicate records ev, received at the given time, and reports whether it
+// repeats the previous event within the window.
+func (d *deduplicator) duplicate(ev Event, at time.Time) bool {
+	dup := d.window > 0 && ev == d.last && at.Sub(d.lastAt) < d.window
+	d.last, d.lastAt = ev, at
+	return dup
+}
+
+// Synthetic key names for scroll wheel steps delivered with WithRelEvents.
+const (
+	RelWheelUp     = "REL_WHEEL_UP"
+	RelWheelDown   = "REL_WHEEL_DOWN"
+	RelHWheelLeft  = "REL_HWHEEL_LEFT"
+	RelHWheelRight = "REL_HWHEEL_RIGHT"
+)
+
+// relKeyNames lists the synthetic key names produced by relKey.
+var relKeyNames = map[string]bool{
+	RelWheelUp:     true,
+	RelWheelDown:   true,
+	RelHWheelLeft:  true,
+	RelHWheelRight: true,
+}
@@ -0,0 +1,295 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/holoplot/go-evdev"
+)
+
+// defaultKeyboardName matches the names of the devices Listen considers
+// keyboards.
+const defaultKeyboardName = "(?i)keyboard"
+
+// findFirstKeyboard returns the path of the first device that supports
+// keyboard events and has "keyboard" in its name, in any case.
+func findFirstKeyboard() (string, error) {
+	return FindKeyboardByName(defaultKeyboardName)
+}
+
+// Listen opens the first detected keyboard device and returns a channel
+// streaming keyboard events. It spawns a goroutine to read events until an error occurs,
+// at which point a Disconnect event is sent and the channel is closed.
+// Use ListenWithHandle to be able to close the device, or ListenWait to
+// wait for a keyboard to be connected. If input devices
+// cannot be opened for lack of permission, the error wraps
+// ErrDevicePermission.
+func Listen(opts ...ListenOption) (<-chan Event, error) {
+	h, err := ListenWithHandle(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return h.Events, nil
+}
+
+// ListenHandle is an event stream opened by ListenWithHandle.
+type ListenHandle struct {
+	// Events streams the keyboard events. After a Disconnect event it is
+	// closed.
+	Events <-chan Event
+
+	dev      *evdev.InputDevice
+	closeErr error
+	once     sync.Once
+}
+
+// Close closes the device, which makes the reading goroutine exit, and
+// drains Events until it is closed. Subsequent calls return the same error.
+func (h *ListenHandle) Close() error {
+	h.once.Do(func() {
+		h.closeErr = h.dev.Close()
+		for range h.Events {
+		}
+	})
+	return h.closeErr
+}
+
+// ListenWithHandle is like Listen, but returns a ListenHandle whose Close
+// method closes the device.
+func ListenWithHandle(opts ...ListenOption) (*ListenHandle, error) {
+	path, err := findFirstKeyboard()
+	if err != nil {
+		return nil, err
+	}
+	return listenPath(path, newListenConfig(opts))
+}
+
+// ListenWait is like ListenWithHandle, but if no keyboard is connected it
+// looks for one every poll interval, set with WithPollInterval, and returns
+// once one appears. It gives up when the context set with WithContext is
+// done, returning its error, or after the timeout set with
+// WithStartupTimeout, returning an error wrapping
+// ErrNoKeyboardFoundWithinTimeout. Other errors, such as
+// ErrDevicePermission, are returned immediately.
+func ListenWait(opts ...ListenOption) (*ListenHandle, error) {
+	cfg := newListenConfig(opts)
+	ctx := cfg.ctx
+	if cfg.startupMax > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.startupMax)
+		defer cancel()
+	}
+	ticker := time.NewTicker(cfg.pollEvery)
+	defer ticker.Stop()
+	for {
+		path, err := findFirstKeyboard()
+		if err == nil {
+			return listenPath(path, cfg)
+		}
+		if !errors.Is(err, ErrNoKeyboardFound) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			if cfg.ctx.Err() == nil {
+				return nil, fmt.Errorf("%w (%v): %w", ErrNoKeyboardFoundWithinTimeout, cfg.startupMax, err)
+			}
+			return nil, cfg.ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// listenPath implements ListenWithHandle for the device at path.
+func listenPath(path string, cfg *listenConfig) (*ListenHandle, error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return nil, openError(path, err)
+	}
+	if cfg.grab {
+		if err := dev.Grab(); err != nil {
+			dev.Close()
+			return nil, fmt.Errorf("%w %s: grabbing: %w", ErrDeviceOpen, path, err)
+		}
+	}
+	var fwd *forwarder
+	if cfg.virtualName != "" {
+		if fwd, err = newForwarder(cfg.virtualName, dev, cfg.remap); err != nil {
+			dev.Close()
+			return nil, fmt.Errorf("%w %s: creating virtual device %q: %w", ErrDeviceOpen, path, cfg.virtualName, err)
+		}
+	}
+	// allows Close to interrupt the blocking read; must come after all
+	// ioctls, which switch the device back to blocking mode
+	if err := dev.NonBlock(); err != nil {
+		dev.Close()
+		if fwd != nil {
+			fwd.close()
+		}
+		return nil, fmt.Errorf("%w %s: setting non-blocking mode: %w", ErrDeviceOpen, path, err)
+	}
+	ctx := cfg.ctx
+	stop := context.AfterFunc(ctx, func() { dev.Close() })
+	out := make(chan Event, cfg.bufferSize)
+	go func() {
+		defer close(out)
+		defer dev.Close()
+		defer stop()
+		if fwd != nil {
+			defer fwd.close()
+		}
+		dedup := deduplicator{window: cfg.dedupWindow}
+		send := func(e Event, at time.Time) {
+			if !dedup.duplicate(e, at) {
+				select {
+				case out <- e:
+				case <-ctx.Done():
+				}
+			}
+		}
+		for {
+			ev, err := dev.ReadOne()
+			if err != nil {
+				if ctx.Err() == nil {
+					out <- Event{Type: Disconnect}
+				}
+				return
+			}
+			if fwd != nil {
+				fwd.forward(ev)
+			}
+			if kev, ok := ConvertEvent(ev); ok {
+				send(kev, eventTime(ev))
+				continue
+			}
+			if cfg.relEvents {
+				if key, ok := relKey(ev); ok {
+					send(Event{Key: key, Type: Press}, eventTime(ev))
+					send(Event{Key: key, Type: Release}, eventTime(ev))
+				}
+			}
+		}
+	}()
+	return &ListenHandle{Events: out, dev: dev}, nil
+}
+
+// ConvertEvent converts a raw EV_KEY input event to an Event. It returns
+// false for other event types and unknown key values.
+func ConvertEvent(ev *evdev.InputEvent) (Event, bool) {
+	if ev.Type != evdev.EV_KEY {
+		return Event{}, false
+	}
+	var et EventType
+	switch ev.Value {
+	case 0:
+		et = Release
+	case 1:
+		et = Press
+	case 2:
+		et = Hold
+	default:
+		return Event{}, false
+	}
+	return Event{Key: internKey(keyCodeName(ev.Code)), Type: et}, true
+}
+
+// keyCodeName returns the evdev code name of the key code, or "RAW_<code>"
+// (e.g., "RAW_500") for codes evdev has no name for.
+func keyCodeName(code evdev.EvCode) string {
+	if name, ok := evdev.EvCodeNameLookup[evdev.EV_KEY][code]; ok {
+		return name
+	}
+	return rawKeyPrefix + strconv.Itoa(int(code))
+}
+
+// ListenAll opens the input device at path and returns a channel streaming
+// both its key events and its absolute axis events, for devices such as
+// joysticks and graphics tablets. Like Listen, it sends a Disconnect event
+// and closes the channel when reading fails. Once ctx is done the device is
+// closed and the channel closed without a Disconnect event, even if the
+// caller stopped receiving.
+func ListenAll(ctx context.Context, path string) (<-chan KeyboardEvent, error) {
+	dev, err := evdev.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	// allows ctx to interrupt the blocking read
+	if err := dev.NonBlock(); err != nil {
+		dev.Close()
+		return nil, fmt.Errorf("%w %s: setting non-blocking mode: %w", ErrDeviceOpen, path, err)
+	}
+	stop := context.AfterFunc(ctx, func() { dev.Close() })
+	out := make(chan KeyboardEvent)
+	go func() {
+		defer close(out)
+		defer dev.Close()
+		defer stop()
+		send := func(e KeyboardEvent) {
+			select {
+			case out <- e:
+			case <-ctx.Done():
+			}
+		}
+		for {
+			ev, err := dev.ReadOne()
+			if err != nil {
+				if ctx.Err() == nil {
+					send(Event{Type: Disconnect})
+				}
+				return
+			}
+			if ev.Type == evdev.EV_ABS {
+				send(AbsEvent{Axis: ev.CodeName(), Value: ev.Value})
+				continue
+			}
+			if kev, ok := ConvertEvent(ev); ok {
+				send(kev)
+			}
+		}
+	}()
+	return out, nil
+}
+
+// eventTime returns the kernel timestamp of a raw input event.
+func eventTime(ev *evdev.InputEvent) time.Time {
+	return time.Unix(int64(ev.Time.Sec), int64(ev.Time.Usec)*int64(time.Microsecond))
+}
+
+// relKey returns the synthetic key name for a raw EV_REL scroll event, or
+// false if ev is not a wheel step.
+func relKey(ev *evdev.InputEvent) (string, bool) {
+	if ev.Type != evdev.EV_REL || ev.Value == 0 {
+		return "", false
+	}
+	switch ev.Code {
+	case evdev.REL_WHEEL:
+		if ev.Value > 0 {
+			return RelWheelUp, true
+		}
+		return RelWheelDown, true
+	case evdev.REL_HWHEEL:
+		if ev.Value > 0 {
+			return RelHWheelRight, true
+		}
+		return RelHWheelLeft, true
+	}
+	return "", false
+}
+
+// ProcessRawEvent converts ev with ConvertEvent and passes the result to
+// HandleEvent, for callers reading devices with their own evdev loop. It
+// returns false, without handling anything, if ev is not a key event.
+func (m *Manager) ProcessRawEvent(ev evdev.InputEvent) bool {
+	kev, ok := ConvertEvent(&ev)
+	if !ok {
+		return false
+	}
+	m.HandleEvent(kev)
+	return true
+}
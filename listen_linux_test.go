@@ -0,0 +1,32 @@
+package keyboard
+
+import (
+	"testing"
+
+	"github.com/holoplot/go-evdev"
+)
+
+func TestConvertEvent(t *testing.T) {
+	for _, tc := range []struct {
+		ev   evdev.InputEvent
+		want Event
+		ok   bool
+	}{
+		{evdev.InputEvent{Type: evdev.EV_KEY, Code: evdev.KEY_A, Value: 1}, press("KEY_A"), true},
+		{evdev.InputEvent{Type: evdev.EV_KEY, Code: evdev.KEY_LEFTCTRL, Value: 0}, release("KEY_LEFTCTRL"), true},
+		{evdev.InputEvent{Type: evdev.EV_KEY, Code: evdev.KEY_A, Value: 2}, hold("KEY_A"), true},
+		{evdev.InputEvent{Type: evdev.EV_KEY, Code: 0x2fe, Value: 1}, press("RAW_766"), true},
+		{evdev.InputEvent{Type: evdev.EV_KEY, Code: evdev.KEY_A, Value: 3}, Event{}, false},
+		{evdev.InputEvent{Type: evdev.EV_REL, Code: evdev.REL_WHEEL, Value: 1}, Event{}, false},
+	} {
+		got, ok := ConvertEvent(&tc.ev)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("ConvertEvent(%v) = %v, %v, want %v, %v", tc.ev, got, ok, tc.want, tc.ok)
+		}
+	}
+
+	ev := evdev.InputEvent{Type: evdev.EV_KEY, Code: evdev.KEY_A, Value: 1}
+	if allocs := testing.AllocsPerRun(100, func() { ConvertEvent(&ev) }); allocs != 0 {
+		t.Errorf("ConvertEvent: %v allocations, want 0", allocs)
+	}
+}
@@ -1,41 +1,85 @@
 // Package keyboard provides utilities for listening to keyboard events on Linux systems
 // using the evdev interface. It allows registering key combinations and
 // handling press, release, and hold events with callback support.
+//
+// The functions that open devices are only available on Linux. On other
+// platforms the package still builds, so that a Manager can be fed events
+// with HandleEvent or a custom Backend; the default Backend is then a
+// StubBackend.
 package keyboard
 
 import (
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/holoplot/go-evdev"
 	"golang.org/x/exp/slices"
 )
 
 // EventType represents the type of a keyboard event: press, release, or hold.
+// The zero value is Unknown so that uninitialized events are not mistaken
+// for releases.
 type EventType int
 
 const (
+	// Unknown is the zero value and does not describe a valid event.
+	Unknown EventType = iota
 	// Release indicates that a key was released.
-	Release EventType = iota
+	Release
 	// Press indicates that a key was pressed.
 	Press
 	// Hold indicates that a key is being held down.
 	Hold
+	// Disconnect indicates that the keyboard device stopped delivering
+	// events. Such events carry no Key.
+	Disconnect
 )
 
-// String returns the string representation of the EventType.
+// ErrUnknownEventType is returned by ParseEventType for strings that do not
+// name a valid EventType.
+var ErrUnknownEventType = errors.New("unknown event type")
+
+// EventTypeStrings holds the names returned by EventType.String. Entries
+// may be replaced to localize them, but only before any events are
+// handled, since the map is read without synchronization. ParseEventType
+// always accepts the English names.
+var EventTypeStrings = map[EventType]string{
+	Unknown:    "Unknown",
+	Release:    "Release",
+	Press:      "Press",
+	Hold:       "Hold",
+	Disconnect: "Disconnect",
+}
+
+// String returns the name of the EventType from EventTypeStrings, or the
+// name of Unknown for values not in it.
 func (e EventType) String() string {
-	switch e {
-	case Press:
-		return "Press"
-	case Release:
-		return "Release"
-	case Hold:
-		return "Hold"
-	default:
-		return "Unknown"
+	if s, ok := EventTypeStrings[e]; ok {
+		return s
 	}
+	return EventTypeStrings[Unknown]
+}
+
+// ParseEventType returns the EventType named by s ("Press", "Release",
+// "Hold" or "Disconnect", case-insensitively). It returns ErrUnknownEventType for any other
+// string, including "Unknown".
+func ParseEventType(s string) (EventType, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "press":
+		return Press, nil
+	case "release":
+		return Release, nil
+	case "hold":
+		return Hold, nil
+	case "disconnect":
+		return Disconnect, nil
+	}
+	return Unknown, fmt.Errorf("%w: %q", ErrUnknownEventType, s)
 }
 
 // Event describes a keyboard event with a key code and its EventType.
@@ -46,77 +90,17 @@ type Event struct {
 	Type EventType
 }
 
-// findFirstKeyboard scans available evdev devices and returns the path
-// of the first device that supports keyboard events.
-func findFirstKeyboard() (string, error) {
-	paths, err := evdev.ListDevicePaths()
-	if err != nil {
-		return "", fmt.Errorf("listing devices: %w", err)
-	}
-	for _, p := range paths {
-		dev, err := evdev.Open(p.Path)
-		if err != nil {
-			continue
-		}
-		types := dev.CapableTypes()
-		has := func(t evdev.EvType) bool {
-			return slices.Contains(types, t)
-		}
-		// require key events and repeat events
-		if !has(evdev.EV_KEY) || !has(evdev.EV_REP) {
-			dev.Close()
-			continue
-		}
-		// check device name for "keyboard"
-		name, err := dev.Name()
-		if err != nil || !strings.Contains(strings.ToLower(name), "keyboard") {
-			dev.Close()
-			continue
-		}
-		dev.Close()
-		return p.Path, nil
-	}
-	return "", fmt.Errorf("no keyboard found")
-}
-
-// Listen opens the first detected keyboard device and returns a channel
-// streaming keyboard events. It spawns a goroutine to read events until an error occurs.
-func Listen() (<-chan Event, error) {
-	path, err := findFirstKeyboard()
-	if err != nil {
-		return nil, err
+// String returns the event type in upper case followed by the short key
+// name, separated by a space (e.g., "PRESS A" for a Press of KEY_A or
+// "RELEASE LEFTCTRL"), or only the type for events without a key such as
+// Disconnect. The format is stable, apart from event type names localized
+// through EventTypeStrings, so that it can be parsed back.
+func (e Event) String() string {
+	t := strings.ToUpper(e.Type.String())
+	if e.Key == "" {
+		return t
 	}
-	dev, err := evdev.Open(path)
-	if err != nil {
-		return nil, fmt.Errorf("opening %s: %w", path, err)
-	}
-	out := make(chan Event)
-	go func() {
-		defer close(out)
-		defer dev.Close()
-		for {
-			ev, err := dev.ReadOne()
-			if err != nil {
-				return
-			}
-			if ev.Type != evdev.EV_KEY {
-				continue
-			}
-			var et EventType
-			switch ev.Value {
-			case 0:
-				et = Release
-			case 1:
-				et = Press
-			case 2:
-				et = Hold
-			default:
-				continue
-			}
-			out <- Event{Key: ev.CodeName(), Type: et}
-		}
-	}()
-	return out, nil
+	return t + " " + keyName(e.Key)
 }
 
 // BindingCallback is the function signature for key combination callbacks.
@@ -124,94 +108,567 @@ type BindingCallback func()
 
 // Manager handles registration of key combination bindings and dispatching
 // callbacks on matching keyboard events.
+//
+// A Manager is safe for concurrent use: all state, including the registered
+// bindings, is guarded by a single lock, and callbacks are dispatched only
+// after HandleEvent releases it. Bindings may therefore be registered or
+// removed from other goroutines, or from inside callbacks, while events are
+// being handled; a binding registered concurrently with HandleEvent applies
+// from the next event on.
 type Manager struct {
-	bindings        map[string]BindingCallback // registered key combos to callbacks
-	pressed         map[string]bool            // currently pressed keys
-	fired           map[string]bool            // combos already fired when suppressRepeats is enabled
-	suppressRepeats bool                       // if true, suppress repeated events
-	mu              sync.Mutex                 // protects internal state
+	bindings        map[string][]*binding // registered bindings keyed by normalized combo
+	active          []*binding            // bindings awaiting release of their trigger key
+	disabledGroups  map[string]bool       // binding groups that must not fire
+	nextID          BindingID             // last assigned binding ID
+	pending         []pendingCall         // matched callbacks to dispatch after unlocking
+	keyWaiters      []chan Event          // WaitForAnyKey callers awaiting the next Press
+	keyMap          func(string) string   // renames keys of incoming events, nil if unset
+	filters         []EventPredicate      // key events not matching all of them are ignored
+	matchTrace      bool                  // if true, record why pressed combos did not match
+	fireHandled     bool                  // a binding handled a combo since last reset by handleForward
+	lastFailure     string                // explanation of the last combo that did not match
+	subs            subscribers           // channels returned by Subscribe
+	hooks           callbackHooks         // hooks run around every callback
+	current         Event                 // event being handled, passed to callback hooks
+	unknownKeys     []uint16              // unknown key codes pressed, for OnUnknownKey hooks
+	backend         Backend               // event source read by Run, nil for the default
+	running         atomic.Int64          // callbacks currently running
+	timers          sync.WaitGroup        // running tickers and pending hold timers
+	closed          bool                  // Close has been called
+	history         *eventRing            // recently handled events
+	axisBindings    []*axisBinding        // registered absolute axis bindings
+	axes            map[string]int32      // last seen value of each absolute axis
+	device          io.Closer             // device read by NewManagerForDevice, nil once closed
+	devicePath      string                // path of the device read by NewManagerForDevice
+	leds            ledState              // lock LED state of the device
+	throttle        *tokenBucket          // limits the event rate, nil if unlimited
+	dropped         atomic.Uint64         // Hold events dropped by throttle
+	keys            *ComboMatcher         // currently pressed keys
+	modAliases      map[string]string     // extra modifier keys to their modifier names
+	logger          *slog.Logger          // receives diagnostics, discarded by default
+	callbackTimeout atomic.Int64          // time.Duration after which callbacks are logged, 0 disables
+	pressedBits     keyBitmask            // lock-free view of pressed keys with small codes
+	modBits         atomic.Uint32         // lock-free view of held modifier keys as Modifiers
+	fired           map[string]bool       // combos already fired when suppressRepeats is enabled
+	suppressRepeats bool                  // if true, suppress repeated events
+	ignoreAltGr     bool                  // if true, KEY_RIGHTALT is not treated as ALT
+	lastKeyFires    bool                  // if true, pressing a modifier can complete a combo
+	dropModRepeats  bool                  // if true, Hold events of modifier keys are dropped
+	a11y            accessibility         // state of the settings applied by SetAccessibility
+	maxComboKeys    int                   // most non-modifier keys in a combo
+	registry        callbackRegistry      // callbacks given to bindings by Restore
+	chordWindow     time.Duration         // modifiers pressed this soon after a chord starts complete it
+	chordStart      time.Time             // first Press of the current chord
+	chordKeys       []string              // non-modifier keys pressed since chordStart
+	staleTimeout    time.Duration         // pressed keys are released after this long, 0 disables
+	stop            chan struct{}         // closed by Close to stop the expiry goroutine
+	mu              sync.RWMutex          // protects internal state
+}
+
+// KeyboardManager is the set of Manager methods used to register bindings
+// and feed events. Code written against it can be tested with a fake such
+// as the one in the testing sub-package.
+type KeyboardManager interface {
+	RegisterBinding(combo string, cb BindingCallback, opts ...BindingOption)
+	RegisterSpec(spec BindingSpec) (BindingID, error)
+	UnregisterAll()
+	HandleEvent(evs ...Event) error
 }
 
-// NewManager creates and returns a pointer to an initialized Manager.
-func NewManager() *Manager {
-	return &Manager{
-		bindings: make(map[string]BindingCallback),
-		pressed:  make(map[string]bool),
-		fired:    make(map[string]bool),
+var _ KeyboardManager = (*Manager)(nil)
+
+// ManagerOption configures a Manager created by NewManager.
+type ManagerOption func(*Manager)
+
+// NewManager creates and returns a pointer to an initialized Manager,
+// applying any options in order.
+func NewManager(opts ...ManagerOption) *Manager {
+	m := &Manager{
+		bindings:       make(map[string][]*binding),
+		disabledGroups: make(map[string]bool),
+		modAliases:     make(map[string]string),
+		logger:         slog.New(slog.DiscardHandler),
+		fired:          make(map[string]bool),
+		axes:           make(map[string]int32),
+		history:        newEventRing(DefaultHistorySize),
+		maxComboKeys:   DefaultMaxComboKeys,
+	}
+	m.keys = newComboMatcher(m.modifierOf)
+	m.a11y.reset()
+	for _, opt := range opts {
+		opt(m)
+	}
+	if m.staleTimeout > 0 {
+		m.stop = make(chan struct{})
+		go m.expireLoop(m.stop)
 	}
+	return m
 }
 
 // SuppressRepeats enables suppression of repeated callback invocations
-// until keys are released.
+// until keys are released: a combo fires at most once until its trigger or
+// one of its modifiers is released, even if further Press events for it
+// arrive. Hold events never fire combos either way. WithSuppressRepeats
+// does the same when creating the Manager.
 func (m *Manager) SuppressRepeats() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 	m.suppressRepeats = true
 }
 
-// RegisterBinding registers a callback for a key combination specified
-// by combo (e.g., "CTRL+ALT+T", "META+L").
-func (m *Manager) RegisterBinding(combo string, cb BindingCallback) {
-	norm := normalizeCombo(combo)
+// TreatAltGrAsAlt controls whether KEY_RIGHTALT counts as the ALT modifier
+// in combos (the default). On layouts where the right Alt key is AltGr, used
+// to type characters such as "€", pass false so that typing them does not
+// trigger ALT bindings; the key is then matched as a regular key, RIGHTALT.
+func (m *Manager) TreatAltGrAsAlt(enabled bool) {
 	m.mu.Lock()
-	m.bindings[norm] = cb
+	defer m.mu.Unlock()
+	m.ignoreAltGr = !enabled
+}
+
+// Reset forgets all pressed keys and suppressed combos, as if every key had
+// been released, and cancels pending hold callbacks without firing release
+// callbacks. Use it when Release events may have been lost, e.g. after the
+// keyboard was disconnected or the application lost focus. HandleEvent
+// calls Reset automatically for Disconnect events.
+func (m *Manager) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reset()
+}
+
+// reset implements Reset. The caller must hold m.mu.
+func (m *Manager) reset() {
+	m.keys.Reset()
+	clear(m.fired)
+	m.pressedBits.reset()
+	m.modBits.Store(0)
+	m.a11y.reset()
+	m.chordKeys = m.chordKeys[:0]
+	for _, b := range m.active {
+		m.deactivate(b)
+	}
+	m.active = nil
+}
+
+// Close releases the Manager's resources: it stops listening to the device
+// of a Manager created with NewManagerForDevice and closes it, stops the
+// stale press expiry of WithStalePressTimeout and closes the channel
+// returned by ComboMatches. Afterwards HealthCheck reports
+// ErrManagerClosed. Subsequent calls are no-ops.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	dev := m.device
+	m.device = nil
+	m.closed = true
+	if m.stop != nil {
+		close(m.stop)
+		m.stop = nil
+	}
 	m.mu.Unlock()
+	m.closeMatches()
+	if dev == nil {
+		return nil
+	}
+	return dev.Close()
+}
+
+// RegisterBinding registers a callback for a key combination specified
+// by combo (e.g., "CTRL+ALT+T", "META+L"), applying any options to the
+// binding. Invalid combos are ignored; use RegisterSpec to get an error
+// for them.
+func (m *Manager) RegisterBinding(combo string, cb BindingCallback, opts ...BindingOption) {
+	spec := BindingSpec{Combo: combo, Callback: cb}
+	for _, opt := range opts {
+		opt(&spec)
+	}
+	if _, err := m.RegisterSpec(spec); err != nil {
+		m.logger.Warn("ignoring invalid binding", "combo", combo, "error", err)
+	}
 }
 
-func normalizeCombo(c string) string {
-	parts := strings.Split(strings.ToUpper(c), "+")
-	return strings.Join(parts, "+")
+// RegisterKeyCallback registers onPress and onRelease, either of which may
+// be nil, to fire when key is pressed while no modifiers are held and when
+// it is released after such a press. key may be a key code or short key
+// name (e.g., "KEY_A" or "A"). It returns the ID of the binding, or 0 and
+// logs a warning if key is unknown or both callbacks are nil.
+func (m *Manager) RegisterKeyCallback(key string, onPress, onRelease BindingCallback) BindingID {
+	code := keyCode(key)
+	if code == "" || (onPress == nil && onRelease == nil) {
+		m.logger.Warn("ignoring invalid key callback", "key", key)
+		return 0
+	}
+	if onPress == nil {
+		// OnRelease only fires after Callback did
+		onPress = func() {}
+	}
+	id, err := m.RegisterSpec(BindingSpec{Combo: keyName(code), Callback: onPress, OnRelease: onRelease})
+	if err != nil {
+		m.logger.Warn("ignoring invalid key callback", "key", key, "error", err)
+		return 0
+	}
+	return id
 }
 
-// HandleEvent processes a single Event, updates internal key state,
-// and invokes any registered callbacks matching the active combination.
-func (m *Manager) HandleEvent(ev Event) {
+// MapKeys installs fn to rename the Key of every event handled afterwards,
+// before filters, the pressed key state and combo matching see it. For
+// example, returning "KEY_ESCAPE" for "KEY_CAPSLOCK" makes CapsLock act as
+// Escape in combos. Calling MapKeys again replaces fn; nil removes it.
+func (m *Manager) MapKeys(fn func(key string) string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	m.keyMap = fn
+}
+
+// IsPressed reports whether the key with the given code (e.g., "KEY_A") is
+// currently held down. For standard keyboard keys it does not take the
+// Manager's lock.
+func (m *Manager) IsPressed(key string) bool {
+	if code, ok := bitmaskCode(key); ok {
+		return m.pressedBits.has(code)
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.keys.isHeld(key)
+}
+
+// CurrentlyPressed returns the codes of all keys currently held down, sorted.
+func (m *Manager) CurrentlyPressed() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	keys := make([]string, 0, m.keys.count())
+	m.keys.each(func(k string, _ time.Time) { keys = append(keys, k) })
+	slices.Sort(keys)
+	return keys
+}
+
+// InferCombo returns the normalized combo the held keys currently form,
+// i.e. the one a Press of the most recently pressed held non-modifier key
+// would fire, without firing any callback. It returns an empty string if
+// no non-modifier key is held, e.g. for a shortcut recorder showing the
+// combo while the user presses keys.
+func (m *Manager) InferCombo() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	combo, _ := m.keys.Match()
+	return combo
+}
+
+// String returns a summary of the Manager's bindings, pressed keys, binding
+// groups and settings for debugging, e.g.
+// "Manager{bindings: 2 [CTRL+A META+L], pressed: [KEY_LEFTCTRL], groups: [editor], disabled groups: [], suppressRepeats: false, ignoreAltGr: false}".
+func (m *Manager) String() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var combos, groups []string
+	n := 0
+	for combo, list := range m.bindings {
+		list = visibleBindings(list)
+		if len(list) == 0 {
+			continue
+		}
+		combos = append(combos, combo)
+		n += len(list)
+		for _, b := range list {
+			if g := b.spec.Group; g != "" && !m.disabledGroups[g] && !slices.Contains(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+	pressed := make([]string, 0, m.keys.count())
+	m.keys.each(func(k string, _ time.Time) { pressed = append(pressed, k) })
+	disabled := make([]string, 0, len(m.disabledGroups))
+	for g, off := range m.disabledGroups {
+		if off {
+			disabled = append(disabled, g)
+		}
+	}
+	slices.Sort(combos)
+	slices.Sort(groups)
+	slices.Sort(pressed)
+	slices.Sort(disabled)
+	return fmt.Sprintf("Manager{bindings: %d %v, pressed: %v, groups: %v, disabled groups: %v, suppressRepeats: %t, ignoreAltGr: %t}",
+		n, combos, pressed, groups, disabled, m.suppressRepeats, m.ignoreAltGr)
+}
+
+// HandleEvent processes the given events in order, updates internal key
+// state, and invokes any registered callbacks matching the active combination.
+// Matched callbacks are dispatched after the Manager's lock is released,
+// so they may call Manager methods (except those using SyncCallback).
+//
+// Combos fire only on Press events. Hold events, which the kernel sends
+// while a key auto-repeats, refresh the key's pressed state but never fire
+// a combo, whether or not repeats are suppressed; use a BindingSpec with a
+// TickInterval to fire repeatedly while a combo is held.
+//
+// Events other than Disconnect with an empty Key are skipped, and the
+// returned error, wrapping ErrKeyNameInvalid, reports the first of them.
+func (m *Manager) HandleEvent(evs ...Event) error {
+	return m.HandleEvents(evs)
+}
+
+// HandleEvents is like HandleEvent but takes a slice. All events are
+// processed under a single acquisition of the Manager's lock, and the
+// callbacks they match are dispatched, in order, once the batch is done.
+func (m *Manager) HandleEvents(evs []Event) error {
+	var err error
+	m.mu.Lock()
+	for _, ev := range evs {
+		if ev.Key == "" && ev.Type != Disconnect {
+			if err == nil {
+				err = fmt.Errorf("%w: %v event without a key", ErrKeyNameInvalid, ev.Type)
+			}
+			continue
+		}
+		m.handleEvent(ev)
+	}
+	calls := m.pending
+	m.pending = nil
+	unknown := m.unknownKeys
+	m.unknownKeys = nil
+	m.mu.Unlock()
+
+	m.hooks.unknownKeys(unknown)
+	m.dispatch(calls)
+	return err
+}
+
+// handleEvent implements HandleEvent. The caller must hold m.mu.
+func (m *Manager) handleEvent(ev Event) {
+	if m.keyMap != nil && ev.Key != "" {
+		ev.Key = m.keyMap(ev.Key)
+	}
+	if m.dropModRepeats && ev.Type == Hold {
+		if _, mod := m.modifierOf(ev.Key); mod {
+			return
+		}
+	}
+	m.handleAccessible(ev)
+}
+
+// processEvent handles ev after key mapping and the accessibility settings
+// have been applied. The caller must hold m.mu.
+func (m *Manager) processEvent(ev Event) {
+	if m.filtered(ev) {
+		return
+	}
+	m.current = ev
+	if m.throttle != nil && !m.throttle.take(time.Now()) && ev.Type == Hold {
+		m.dropped.Add(1)
+		return
+	}
+	m.history.push(ev)
+	if ev.Type == Disconnect {
+		m.reset()
+		return
+	}
 
 	key := ev.Key
-	mod := isModifier(key)
+	modName, mod := m.modifierOf(key)
+
+	// a chord starts with the first key pressed while none are held, or
+	// after the window of the previous chord expired
+	if ev.Type == Press && (m.keys.count() == 0 || (m.chordWindow > 0 && !m.inChordWindow())) {
+		m.chordStart = time.Now()
+		m.chordKeys = m.chordKeys[:0]
+	}
 
 	// update pressed keys
+	m.keys.UpdateKey(key, ev.Type)
+	code, bit := bitmaskCode(key)
 	if ev.Type == Press {
-		m.pressed[key] = true
+		if m.subs.any() {
+			m.publish(KeyPressedEvent{Key: key})
+		}
+		if code, ok := rawKeyCode(key); ok && m.hooks.hasUnknown.Load() {
+			m.unknownKeys = append(m.unknownKeys, code)
+		}
+		if bit {
+			m.pressedBits.set(code)
+		}
+		if !mod && m.chordWindow > 0 {
+			m.chordKeys = append(m.chordKeys, key)
+		}
+		m.updateModifierBits(key, true)
+		for _, w := range m.keyWaiters {
+			w <- ev
+		}
+		m.keyWaiters = nil
 	} else if ev.Type == Release {
-		delete(m.pressed, key)
+		if m.subs.any() {
+			m.publish(KeyReleasedEvent{Key: key})
+		}
+		if bit {
+			m.pressedBits.clear(code)
+		}
+		m.updateModifierBits(key, false)
+		m.releaseBindings(keyName(key))
 		if m.suppressRepeats {
+			if mod {
+				m.clearFiredModifier(modName)
+			}
 			suffix := keyName(key)
 			for combo := range m.fired {
-				parts := strings.Split(combo, "+")
-				if parts[len(parts)-1] == suffix {
+				if comboTrigger(combo) == suffix {
 					delete(m.fired, combo)
 				}
 			}
 		}
 	}
 
-	// on press of non-modifier, build combo and maybe fire callback
+	// on press of non-modifier, build combo and maybe fire callback,
+	// preferring one including the other held non-modifier keys
 	if ev.Type == Press && !mod {
-		comboParts := []string{}
-		for k := range m.pressed {
-			if isModifier(k) {
-				comboParts = append(comboParts, modifierName(k))
+		combo := m.keys.keysCombo(m.maxComboKeys)
+		if len(m.bindings[combo]) == 0 || !m.fireCombo(combo) {
+			m.fireCombo(m.keys.comboFor(key))
+		}
+	} else if ev.Type == Press && (m.lastKeyFires || m.inChordWindow()) {
+		// the modifier may complete a combo whose trigger is already held,
+		// or be the trigger itself (e.g., "CTRL+LEFTSHIFT"); within a chord
+		// window only triggers pressed during the chord count
+		m.fireCombo(m.keys.comboFor(key))
+		for held := range m.keys.pressed {
+			if _, isMod := m.keys.mods[held]; !isMod && (m.lastKeyFires || slices.Contains(m.chordKeys, held)) {
+				m.fireCombo(m.keys.comboFor(held))
 			}
 		}
-		comboParts = append(comboParts, keyName(key))
-		combo := strings.Join(comboParts, "+")
+	}
+}
 
-		if m.suppressRepeats {
-			if m.fired[combo] {
-				return
+// fireCombo presses the bindings matching combo, in priority order, until
+// one handles it, and reports whether one did. The caller must hold m.mu.
+func (m *Manager) fireCombo(combo string) bool {
+	if m.suppressRepeats {
+		if m.fired[combo] {
+			return false
+		}
+		m.fired[combo] = true
+	}
+	handled := false
+	matched := m.matchingBindings(combo)
+	for _, b := range matched {
+		if m.pressBinding(b) {
+			handled = true
+			if !b.spec.ContinuePropagation {
+				break
 			}
-			m.fired[combo] = true
 		}
+	}
+	m.fireHandled = m.fireHandled || handled
+	if m.matchTrace && !handled {
+		m.lastFailure = m.explainMiss(combo, matched)
+	}
+	return handled
+}
 
-		if cb, ok := m.bindings[combo]; ok {
-			go cb()
+// clearFiredModifier forgets fired combos that include the modifier name,
+// unless another key for the same modifier (e.g., the right-hand CTRL) is
+// still held. The caller must hold m.mu.
+func (m *Manager) clearFiredModifier(name string) {
+	if bits, ok := modifierNameBits[name]; ok && len(m.modAliases) == 0 {
+		if m.ActiveModifiers()&bits != 0 {
+			return
+		}
+	} else {
+		if m.keys.isModifierHeld(name) {
+			return
+		}
+	}
+	for combo := range m.fired {
+		parts := strings.Split(combo, "+")
+		if slices.Contains(parts[:len(parts)-1], name) {
+			delete(m.fired, combo)
 		}
 	}
 }
 
+// keyPool holds one canonical copy of every key name seen by Listen.
+var keyPool sync.Map
+
+// internKey returns the canonical copy of key, so repeated events for the
+// same key share a single string.
+func internKey(key string) string {
+	if v, ok := keyPool.Load(key); ok {
+		return v.(string)
+	}
+	v, _ := keyPool.LoadOrStore(key, key)
+	return v.(string)
+}
+
+// modifierOf returns the modifier name of key and whether key acts as a
+// modifier for this Manager, taking modifier aliases and the AltGr setting
+// into account. The caller must hold m.mu.
+func (m *Manager) modifierOf(key string) (string, bool) {
+	if name, ok := m.modAliases[key]; ok {
+		return name, true
+	}
+	if !isModifier(key) || (m.ignoreAltGr && IsAltGr(key)) {
+		return "", false
+	}
+	return modifierName(key), true
+}
+
+// isModifierName reports whether name is a built-in or aliased modifier
+// name. The caller must hold m.mu.
+func (m *Manager) isModifierName(name string) bool {
+	if isModifierName(name) {
+		return true
+	}
+	for _, alias := range m.modAliases {
+		if alias == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizeCombo returns the normalized form of combo as the Manager
+// registers it, like ParseCombo but recognizing the modifier aliases set
+// with WithModifierAliases and enforcing the limit set with
+// WithMaxComboKeys.
+func (m *Manager) NormalizeCombo(combo string) (string, error) {
+	return m.parseCombo(combo)
+}
+
+// parseCombo is ParseCombo extended with the Manager's modifier aliases,
+// and rejects combos with more than maxComboKeys non-modifier keys.
+func (m *Manager) parseCombo(combo string) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var norm string
+	var err error
+	if len(m.modAliases) == 0 {
+		norm, err = ParseCombo(combo)
+	} else {
+		norm, err = parseCombo(combo, m.isModifierName)
+	}
+	if err != nil {
+		return "", err
+	}
+	keys := m.comboKeys(norm)
+	if len(keys) > m.maxComboKeys {
+		return "", fmt.Errorf("%w: combo %q has more than %d non-modifier keys", ErrKeyNameInvalid, combo, m.maxComboKeys)
+	}
+	for _, k := range keys[:len(keys)-1] {
+		if name, mod := m.modifierOf(keyCode(k)); mod {
+			return "", fmt.Errorf("%w: modifier key %q must be given as %s in combo %q", ErrKeyNameInvalid, k, name, combo)
+		}
+	}
+	return norm, nil
+}
+
+// comboKeys returns the non-modifier keys of a normalized combo, ending with
+// its trigger. The caller must hold m.mu.
+func (m *Manager) comboKeys(combo string) []string {
+	parts := strings.Split(combo, "+")
+	i := 0
+	for i < len(parts)-1 && (parts[i] == wildcard || m.isModifierName(parts[i])) {
+		i++
+	}
+	return parts[i:]
+}
+
 // isModifier returns true if the given key code is a modifier key.
 func isModifier(key string) bool {
 	switch key {
@@ -224,6 +681,12 @@ func isModifier(key string) bool {
 	return false
 }
 
+// IsAltGr reports whether key is the AltGr (ISO level 3 shift) key, which
+// evdev reports as KEY_RIGHTALT.
+func IsAltGr(key string) bool {
+	return key == "KEY_RIGHTALT"
+}
+
 // modifierName converts a modifier key code (e.g., "KEY_LEFTCTRL") to its
 // human-readable name (e.g., "CTRL").
 func modifierName(key string) string {
@@ -0,0 +1,558 @@
+package keyboard
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func press(key string) Event   { return Event{Key: key, Type: Press} }
+func release(key string) Event { return Event{Key: key, Type: Release} }
+func hold(key string) Event    { return Event{Key: key, Type: Hold} }
+
+// tap returns a Press followed by a Release of key.
+func tap(key string) []Event { return []Event{press(key), release(key)} }
+
+// seq concatenates event sequences.
+func seq(parts ...[]Event) []Event {
+	var evs []Event
+	for _, p := range parts {
+		evs = append(evs, p...)
+	}
+	return evs
+}
+
+// eventCase is a sequence of events fed to a Manager with combos registered
+// and the number of times each combo is expected to fire.
+type eventCase struct {
+	name   string
+	opts   []ManagerOption
+	combos []string
+	events []Event
+	want   map[string]int // combos missing from want must not fire
+}
+
+// newCountingManager returns a Manager with a Sync binding for each combo
+// counting its callbacks in the returned map.
+func newCountingManager(t testing.TB, combos []string, opts ...ManagerOption) (*Manager, map[string]int) {
+	t.Helper()
+	m := NewManager(opts...)
+	fired := make(map[string]int)
+	for _, combo := range combos {
+		if _, err := m.RegisterSpec(BindingSpec{Combo: combo, Callback: func() { fired[combo]++ }, Mode: Sync}); err != nil {
+			t.Fatalf("RegisterSpec(%q): %v", combo, err)
+		}
+	}
+	return m, fired
+}
+
+func runEventCases(t *testing.T, cases []eventCase) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, fired := newCountingManager(t, tc.combos, tc.opts...)
+			if err := m.HandleEvent(tc.events...); err != nil {
+				t.Fatalf("HandleEvent: %v", err)
+			}
+			for _, combo := range tc.combos {
+				if got, want := fired[combo], tc.want[combo]; got != want {
+					t.Errorf("%s fired %d times, want %d", combo, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleEvent_BasicPress(t *testing.T) {
+	runEventCases(t, []eventCase{
+		{
+			name:   "single press",
+			combos: []string{"A"},
+			events: []Event{press("KEY_A")},
+			want:   map[string]int{"A": 1},
+		},
+		{
+			name:   "press after release",
+			combos: []string{"A"},
+			events: seq(tap("KEY_A"), tap("KEY_A")),
+			want:   map[string]int{"A": 2},
+		},
+		{
+			name:   "other key",
+			combos: []string{"A"},
+			events: tap("KEY_B"),
+		},
+		{
+			name:   "two keys",
+			combos: []string{"A+B", "B"},
+			events: []Event{press("KEY_A"), press("KEY_B")},
+			want:   map[string]int{"A+B": 1},
+		},
+		{
+			name:   "two keys falls back to trigger",
+			combos: []string{"B"},
+			events: []Event{press("KEY_A"), press("KEY_B")},
+			want:   map[string]int{"B": 1},
+		},
+		{
+			name:   "two keys in wrong order",
+			combos: []string{"A+B"},
+			events: []Event{press("KEY_B"), press("KEY_A")},
+		},
+	})
+}
+
+func TestHandleEvent_ModifierCombo(t *testing.T) {
+	runEventCases(t, []eventCase{
+		{
+			name:   "left modifier",
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1},
+		},
+		{
+			name:   "right modifier",
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_RIGHTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1},
+		},
+		{
+			name:   "modifiers pressed in reverse order",
+			combos: []string{"CTRL+SHIFT+A"},
+			events: []Event{press("KEY_LEFTSHIFT"), press("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+SHIFT+A": 1},
+		},
+		{
+			name:   "registered in any order",
+			combos: []string{"meta+alt+shift+ctrl+a"},
+			events: []Event{press("KEY_LEFTALT"), press("KEY_LEFTMETA"), press("KEY_RIGHTSHIFT"), press("KEY_RIGHTCTRL"), press("KEY_A")},
+			want:   map[string]int{"meta+alt+shift+ctrl+a": 1},
+		},
+		{
+			name:   "extra modifier",
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_LEFTSHIFT"), press("KEY_A")},
+		},
+		{
+			name:   "modifier pressed after trigger",
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_A"), press("KEY_LEFTCTRL")},
+		},
+		{
+			name:   "wildcard",
+			combos: []string{"*+A"},
+			events: seq(tap("KEY_A"), []Event{press("KEY_LEFTCTRL"), press("KEY_A")}),
+			want:   map[string]int{"*+A": 2},
+		},
+		{
+			name:   "exact combo before wildcard",
+			combos: []string{"CTRL+A", "*+A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1},
+		},
+		{
+			name:   "modifier and two keys",
+			combos: []string{"CTRL+A+B", "CTRL+B"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A"), press("KEY_B")},
+			want:   map[string]int{"CTRL+A+B": 1},
+		},
+	})
+}
+
+func TestHandleEvent_ModifierOrderDeterministic(t *testing.T) {
+	mods := []string{"KEY_LEFTMETA", "KEY_RIGHTALT", "KEY_LEFTSHIFT", "KEY_RIGHTCTRL"}
+	for round := range 50 {
+		// every rotation of the press order, many times over to catch
+		// map iteration order
+		evs := make([]Event, 0, len(mods)+1)
+		for i := range mods {
+			evs = append(evs, press(mods[(round+i)%len(mods)]))
+		}
+		evs = append(evs, press("KEY_A"))
+		m, fired := newCountingManager(t, []string{"CTRL+SHIFT+ALT+META+A", "CTRL+SHIFT+A"})
+		m.HandleEvent(evs...)
+		if got := m.InferCombo(); got != "CTRL+SHIFT+ALT+META+A" {
+			t.Fatalf("InferCombo after %v = %q, want CTRL+SHIFT+ALT+META+A", evs, got)
+		}
+		if fired["CTRL+SHIFT+ALT+META+A"] != 1 || fired["CTRL+SHIFT+A"] != 0 {
+			t.Fatalf("after %v fired %v, want only CTRL+SHIFT+ALT+META+A once", evs, fired)
+		}
+	}
+}
+
+func TestHandleEvent_SuppressRepeats(t *testing.T) {
+	runEventCases(t, []eventCase{
+		{
+			name:   "repeated press",
+			combos: []string{"A"},
+			events: []Event{press("KEY_A"), press("KEY_A")},
+			want:   map[string]int{"A": 2},
+		},
+		{
+			name:   "repeated press suppressed",
+			opts:   []ManagerOption{WithSuppressRepeats()},
+			combos: []string{"A"},
+			events: []Event{press("KEY_A"), press("KEY_A")},
+			want:   map[string]int{"A": 1},
+		},
+		{
+			name:   "trigger release ends suppression",
+			opts:   []ManagerOption{WithSuppressRepeats()},
+			combos: []string{"A"},
+			events: seq(tap("KEY_A"), tap("KEY_A")),
+			want:   map[string]int{"A": 2},
+		},
+		{
+			name:   "modifier release ends suppression",
+			opts:   []ManagerOption{WithSuppressRepeats()},
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_LEFTCTRL"), press("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 2},
+		},
+		{
+			name:   "other modifier key still held",
+			opts:   []ManagerOption{WithSuppressRepeats()},
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_RIGHTCTRL"), press("KEY_A"), release("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1},
+		},
+	})
+}
+
+func TestHandleEvent_HoldEvent(t *testing.T) {
+	repeat := []Event{press("KEY_LEFTCTRL"), press("KEY_A"), hold("KEY_A"), hold("KEY_A"), hold("KEY_A")}
+	runEventCases(t, []eventCase{
+		{
+			name:   "hold does not fire",
+			combos: []string{"CTRL+A"},
+			events: repeat,
+			want:   map[string]int{"CTRL+A": 1},
+		},
+		{
+			name:   "hold does not fire with repeats suppressed",
+			opts:   []ManagerOption{WithSuppressRepeats()},
+			combos: []string{"CTRL+A"},
+			events: repeat,
+			want:   map[string]int{"CTRL+A": 1},
+		},
+		{
+			name:   "hold without press",
+			combos: []string{"A"},
+			events: []Event{hold("KEY_A"), hold("KEY_A")},
+		},
+		{
+			name:   "modifier hold",
+			combos: []string{"CTRL+A"},
+			events: []Event{press("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1},
+		},
+	})
+}
+
+func TestHandleEvent_NoSpuriousFire(t *testing.T) {
+	runEventCases(t, []eventCase{
+		{
+			name:   "trigger alone",
+			combos: []string{"CTRL+A"},
+			events: tap("KEY_A"),
+		},
+		{
+			name:   "modifier alone",
+			combos: []string{"CTRL+A", "A"},
+			events: tap("KEY_LEFTCTRL"),
+		},
+		{
+			name:   "modifier released before trigger",
+			combos: []string{"CTRL+A"},
+			events: seq(tap("KEY_LEFTCTRL"), tap("KEY_A")),
+		},
+		{
+			name:   "release does not fire",
+			combos: []string{"A"},
+			events: []Event{release("KEY_A")},
+		},
+		{
+			name:   "combo with modifiers does not fire plain key",
+			combos: []string{"A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A")},
+		},
+	})
+}
+
+func TestHandleEvent_ModifierReleaseClearsState(t *testing.T) {
+	runEventCases(t, []eventCase{
+		{
+			name:   "plain key after combo",
+			combos: []string{"CTRL+A", "A"},
+			events: seq([]Event{press("KEY_LEFTCTRL")}, tap("KEY_A"), []Event{release("KEY_LEFTCTRL")}, tap("KEY_A")),
+			want:   map[string]int{"CTRL+A": 1, "A": 1},
+		},
+		{
+			name:   "trigger still held",
+			combos: []string{"CTRL+A", "A"},
+			events: []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_LEFTCTRL"), press("KEY_A")},
+			want:   map[string]int{"CTRL+A": 1, "A": 1},
+		},
+		{
+			name:   "disconnect",
+			combos: []string{"CTRL+A", "A"},
+			events: []Event{press("KEY_LEFTCTRL"), {Type: Disconnect}, press("KEY_A")},
+			want:   map[string]int{"A": 1},
+		},
+	})
+}
+
+func TestHandleEvent_EmptyKey(t *testing.T) {
+	for _, et := range []EventType{Press, Release, Hold, Unknown} {
+		m, fired := newCountingManager(t, []string{"CTRL+A"})
+		err := m.HandleEvent(press("KEY_LEFTCTRL"), Event{Type: et}, press("KEY_A"))
+		if !errors.Is(err, ErrKeyNameInvalid) {
+			t.Errorf("%v event without a key: error = %v, want ErrKeyNameInvalid", et, err)
+		}
+		if fired["CTRL+A"] != 1 {
+			t.Errorf("%v event without a key: CTRL+A fired %d times, want 1", et, fired["CTRL+A"])
+		}
+		if got := m.CurrentlyPressed(); len(got) != 2 {
+			t.Errorf("%v event without a key: pressed keys = %q, want CTRL and A", et, got)
+		}
+	}
+	m := NewManager()
+	if err := m.HandleEvent(Event{Type: Disconnect}); err != nil {
+		t.Errorf("Disconnect: error = %v, want nil", err)
+	}
+}
+
+func TestHandleEvent_ConcurrentAccess(t *testing.T) {
+	t.Parallel()
+	m := NewManager()
+	var fired atomic.Int64
+	m.RegisterBinding("CTRL+A", func() { fired.Add(1) }, ReentrantCallback())
+
+	const workers, rounds = 8, 200
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				// one batch, so that no other worker's release splits the combo
+				m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"))
+			}
+		}()
+	}
+	wg.Wait()
+	if got := fired.Load(); got != workers*rounds {
+		t.Errorf("CTRL+A fired %d times, want %d", got, workers*rounds)
+	}
+}
+
+func TestHandleEvent_CallbacksCallManager(t *testing.T) {
+	for _, mode := range []CallbackMode{Async, SyncReentrant} {
+		m := NewManager()
+		var wg sync.WaitGroup
+		var fired atomic.Int64
+		cb := func() {
+			defer wg.Done()
+			fired.Add(1)
+			// every Manager method must be usable from a callback
+			m.RegisterBinding("CTRL+B", func() {})
+			m.UnregisterCombo("CTRL+B")
+			m.IsPressed("KEY_A")
+			m.CurrentlyPressed()
+			m.HandleEvent(press("KEY_C"), release("KEY_C"))
+		}
+		if _, err := m.RegisterSpec(BindingSpec{Combo: "CTRL+A", Callback: cb, Mode: mode}); err != nil {
+			t.Fatal(err)
+		}
+
+		const workers, rounds = 4, 50
+		wg.Add(workers * rounds)
+		done := make(chan struct{})
+		go func() {
+			var feeders sync.WaitGroup
+			for range workers {
+				feeders.Add(1)
+				go func() {
+					defer feeders.Done()
+					for range rounds {
+						m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"))
+					}
+				}()
+			}
+			feeders.Wait()
+			wg.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("mode %v: deadlock after %d callbacks", mode, fired.Load())
+		}
+	}
+}
+
+func TestHandleEvent_ConcurrentRegister(t *testing.T) {
+	m := NewManager()
+	var fired atomic.Int64
+	m.RegisterBinding("CTRL+A", func() { fired.Add(1) }, ReentrantCallback())
+
+	const rounds = 500
+	var wg sync.WaitGroup
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			m.HandleEvent(press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), press("KEY_B"), release("KEY_B"))
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			id, _ := m.RegisterSpec(BindingSpec{Combo: "CTRL+B", Callback: func() {}, Group: "g"})
+			m.Unregister(id)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			m.RegisterSpec(BindingSpec{Combo: "SHIFT+B", Callback: func() {}, Group: "g"})
+			m.GroupBindings("g")
+			m.UnregisterAllInGroup("g")
+			m.Snapshot()
+		}
+	}()
+	wg.Wait()
+	if got := fired.Load(); got != rounds {
+		t.Errorf("CTRL+A fired %d times, want %d", got, rounds)
+	}
+	if got := m.GroupBindings(""); len(got) != 1 || got[0] != "CTRL+A" {
+		t.Errorf("bindings = %v, want [CTRL+A]", got)
+	}
+}
+
+func TestHandleEvent_IgnoreRepeatModifiers(t *testing.T) {
+	evs := []Event{press("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), hold("KEY_LEFTCTRL"), press("KEY_A"), hold("KEY_A")}
+	for _, tc := range []struct {
+		name string
+		opts []ManagerOption
+		want int // events in the history
+	}{
+		{"default", nil, 5},
+		{"ignored", []ManagerOption{IgnoreRepeatModifiers()}, 3},
+	} {
+		m, fired := newCountingManager(t, []string{"CTRL+A"}, tc.opts...)
+		m.HandleEvent(evs...)
+		if fired["CTRL+A"] != 1 {
+			t.Errorf("%s: CTRL+A fired %d times, want 1", tc.name, fired["CTRL+A"])
+		}
+		if got := m.EventHistory(10); len(got) != tc.want {
+			t.Errorf("%s: history = %v, want %d events", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestHandleEvent_UseModifierBitmask(t *testing.T) {
+	// tracking modifiers in a bitmask must not change which combos fire
+	combos := []string{"CTRL+A", "CTRL+SHIFT+A", "ALT+B", "*+B", "A+B", "META+C", "C"}
+	keys := []string{"KEY_LEFTCTRL", "KEY_RIGHTCTRL", "KEY_LEFTSHIFT", "KEY_RIGHTALT", "KEY_LEFTMETA", "KEY_A", "KEY_B", "KEY_C"}
+	types := []EventType{Press, Press, Release, Hold}
+	r := rand.New(rand.NewSource(1))
+	for round := range 200 {
+		plain, mapFired := newCountingManager(t, combos)
+		bits, bitFired := newCountingManager(t, combos, UseModifierBitmask())
+		var evs []Event
+		for range 30 {
+			ev := Event{Key: keys[r.Intn(len(keys))], Type: types[r.Intn(len(types))]}
+			evs = append(evs, ev)
+			plain.HandleEvent(ev)
+			bits.HandleEvent(ev)
+			if a, b := plain.InferCombo(), bits.InferCombo(); a != b {
+				t.Fatalf("round %d after %v: InferCombo = %q with maps, %q with bitmask", round, evs, a, b)
+			}
+			if a, b := plain.ActiveModifiers(), bits.ActiveModifiers(); a != b {
+				t.Fatalf("round %d after %v: ActiveModifiers = %v with maps, %v with bitmask", round, evs, a, b)
+			}
+		}
+		for _, c := range combos {
+			if mapFired[c] != bitFired[c] {
+				t.Fatalf("round %d after %v: %s fired %d times with maps, %d with bitmask", round, evs, c, mapFired[c], bitFired[c])
+			}
+		}
+	}
+}
+
+func TestHandleEvent_Allocs(t *testing.T) {
+	// building a combo allocates only its string; the invariant check
+	// installed by the tests allocates too
+	check := checkCombo
+	checkCombo = nil
+	defer func() { checkCombo = check }()
+	for _, tc := range []struct {
+		name string
+		evs  []Event
+		want float64
+	}{
+		{"key without binding", tap("KEY_A"), 0},
+		{"hold", []Event{hold("KEY_A"), hold("KEY_LEFTCTRL")}, 0},
+		{"combo without binding", seq([]Event{press("KEY_LEFTCTRL"), press("KEY_LEFTSHIFT")}, tap("KEY_B"), []Event{release("KEY_LEFTSHIFT"), release("KEY_LEFTCTRL")}), 1},
+		{"held keys", []Event{press("KEY_A"), press("KEY_C"), release("KEY_C"), release("KEY_A")}, 1},
+	} {
+		m, _ := newCountingManager(t, []string{"CTRL+A", "A+B"})
+		if allocs := testing.AllocsPerRun(100, func() { m.HandleEvent(tc.evs...) }); allocs > tc.want {
+			t.Errorf("%s: %v allocations per HandleEvent, want %v", tc.name, allocs, tc.want)
+		}
+	}
+	if allocs := testing.AllocsPerRun(100, func() { internKey("KEY_A") }); allocs != 0 {
+		t.Errorf("internKey: %v allocations, want 0", allocs)
+	}
+}
+
+// readManager calls every Manager method that takes only the read lock.
+func readManager(m *Manager) {
+	m.IsPressed("KEY_A")
+	m.IsPressed("BTN_LEFT")
+	m.CurrentlyPressed()
+	m.InferCombo()
+	m.ListGroups()
+	m.GroupBindings("")
+	m.PossibleCompletions([]string{"CTRL"})
+	m.EventHistory(4)
+	m.KeyState()
+	m.LEDState()
+	m.Snapshot()
+	m.Accessibility()
+	m.HealthCheck()
+	m.LastMatchFailure()
+	ValidateBindings(m)
+	_ = m.String()
+}
+
+func TestHandleEvent_ConcurrentReads(t *testing.T) {
+	// run with -race: read-locked methods must not modify the Manager
+	m := NewManager()
+	m.RegisterBinding("CTRL+A", func() {}, ReentrantCallback())
+	const rounds = 200
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range rounds {
+				readManager(m)
+			}
+		}()
+	}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for range rounds {
+			m.HandleEvent(press("KEY_LEFTCTRL"), press("BTN_LEFT"), press("KEY_A"), release("KEY_A"), release("BTN_LEFT"), release("KEY_LEFTCTRL"))
+			id, _ := m.RegisterSpec(BindingSpec{Combo: "SHIFT+B", Callback: func() {}, Group: "g"})
+			m.Unregister(id)
+		}
+	}()
+	wg.Wait()
+}
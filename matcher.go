@@ -0,0 +1,205 @@
+package keyboard
+
+import (
+	"math/bits"
+	"strings"
+	"time"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+// ComboMatcher tracks which keys are held and answers which combo they
+// currently form, without bindings or callbacks. It is the matching logic
+// used by Manager, for callers running their own dispatch loop. A
+// ComboMatcher is not safe for concurrent use.
+type ComboMatcher struct {
+	pressed    map[string]time.Time            // held keys to their last Press or Hold
+	mods       map[string]string               // held modifier keys to their modifier names
+	last       string                          // most recently pressed held non-modifier key
+	held       []string                        // held non-modifier keys in press order
+	modifierOf func(key string) (string, bool) // resolves modifier keys to modifier names
+	useBits    bool                            // track modifier keys in bits instead of the maps
+	bits       Modifiers                       // held modifier keys when useBits is set
+	bitsAt     [len(modifierKeys)]time.Time    // last Press or Hold of each modifier key in bits
+}
+
+// NewComboMatcher returns a ComboMatcher with no keys held, recognizing the
+// built-in modifier keys.
+func NewComboMatcher() *ComboMatcher {
+	return newComboMatcher(func(key string) (string, bool) {
+		if !isModifier(key) {
+			return "", false
+		}
+		return modifierName(key), true
+	})
+}
+
+// newComboMatcher returns a ComboMatcher resolving modifiers with modifierOf.
+func newComboMatcher(modifierOf func(key string) (string, bool)) *ComboMatcher {
+	return &ComboMatcher{
+		pressed:    make(map[string]time.Time),
+		mods:       make(map[string]string),
+		modifierOf: modifierOf,
+	}
+}
+
+// UpdateKey records an event of type et for the key with the given code
+// (e.g., "KEY_A"). Hold events for keys not held are ignored.
+func (c *ComboMatcher) UpdateKey(key string, et EventType) {
+	if bit, ok := c.bitFor(key); ok {
+		switch {
+		case et == Press || (et == Hold && c.bits&bit != 0):
+			c.bits |= bit
+			c.bitsAt[bitIndex(bit)] = time.Now()
+			return
+		case et == Release && c.bits&bit != 0:
+			c.bits &^= bit
+			return
+		}
+	}
+	switch et {
+	case Press:
+		c.pressed[key] = time.Now()
+		if name, mod := c.modifierOf(key); mod {
+			c.mods[key] = name
+		} else {
+			c.last = key
+			c.held = append(slices.DeleteFunc(c.held, func(k string) bool { return k == key }), key)
+		}
+	case Hold:
+		if _, ok := c.pressed[key]; ok {
+			c.pressed[key] = time.Now()
+		}
+	case Release:
+		delete(c.pressed, key)
+		delete(c.mods, key)
+		c.held = slices.DeleteFunc(c.held, func(k string) bool { return k == key })
+		if key == c.last {
+			c.last = ""
+		}
+	}
+}
+
+// Match returns the normalized combo formed by the held modifiers and the
+// most recently pressed non-modifier key that is still held. It returns
+// false if no non-modifier key is held.
+func (c *ComboMatcher) Match() (combo string, matched bool) {
+	if c.last == "" {
+		return "", false
+	}
+	return c.comboFor(c.last), true
+}
+
+// Reset forgets all held keys.
+func (c *ComboMatcher) Reset() {
+	clear(c.pressed)
+	clear(c.mods)
+	c.bits = 0
+	c.held = c.held[:0]
+	c.last = ""
+}
+
+// comboFor returns the combo formed by the held modifiers, other than key
+// itself, and key as the trigger.
+func (c *ComboMatcher) comboFor(key string) string {
+	// up to 8 modifier keys fit without a heap allocation
+	var buf [8]string
+	return joinCombo(c.appendMods(buf[:0], key), keyName(key))
+}
+
+// keysCombo returns the combo formed by the held modifiers and all held
+// non-modifier keys in press order (e.g., "CTRL+A+B"), or an empty string
+// unless between two and max of them are held.
+func (c *ComboMatcher) keysCombo(max int) string {
+	if len(c.held) < 2 || len(c.held) > max {
+		return ""
+	}
+	var buf, keyBuf [8]string
+	mods := c.appendMods(buf[:0], "")
+	keys := keyBuf[:0]
+	for _, k := range c.held {
+		keys = append(keys, keyName(k))
+	}
+	return joinCombo(mods, strings.Join(keys, "+"))
+}
+
+// bitFor returns the Modifiers bit tracking key, and false if key is not
+// tracked in bits because useBits is unset or key does not act as its
+// usual modifier.
+func (c *ComboMatcher) bitFor(key string) (Modifiers, bool) {
+	if !c.useBits {
+		return 0, false
+	}
+	bit, ok := modifierBits[key]
+	if !ok {
+		return 0, false
+	}
+	name, mod := c.modifierOf(key)
+	return bit, mod && name == modifierName(key)
+}
+
+// appendMods appends the modifier names of the held modifier keys other
+// than except to mods.
+func (c *ComboMatcher) appendMods(mods []string, except string) []string {
+	for held, name := range c.mods {
+		if held != except {
+			mods = append(mods, name)
+		}
+	}
+	for b := c.bits; b != 0; b &= b - 1 {
+		if key := modifierKeys[bitIndex(b&-b)]; key != except {
+			mods = append(mods, modifierName(key))
+		}
+	}
+	return mods
+}
+
+// each calls fn for every held key with its last Press or Hold.
+func (c *ComboMatcher) each(fn func(key string, at time.Time)) {
+	for key, at := range c.pressed {
+		fn(key, at)
+	}
+	for b := c.bits; b != 0; b &= b - 1 {
+		i := bitIndex(b & -b)
+		fn(modifierKeys[i], c.bitsAt[i])
+	}
+}
+
+// count returns the number of held keys.
+func (c *ComboMatcher) count() int {
+	return len(c.pressed) + bits.OnesCount32(uint32(c.bits))
+}
+
+// isHeld reports whether key is held.
+func (c *ComboMatcher) isHeld(key string) bool {
+	if bit, ok := modifierBits[key]; ok && c.bits&bit != 0 {
+		return true
+	}
+	_, ok := c.pressed[key]
+	return ok
+}
+
+// isModifierHeld reports whether a key acting as the modifier name is held.
+func (c *ComboMatcher) isModifierHeld(name string) bool {
+	if c.bits&modifierNameBits[name] != 0 {
+		return true
+	}
+	for _, held := range c.mods {
+		if held == name {
+			return true
+		}
+	}
+	return false
+}
+
+// modifierKeysHeld returns the held modifier keys mapped to their modifier
+// names.
+func (c *ComboMatcher) modifierKeysHeld() map[string]string {
+	mods := maps.Clone(c.mods)
+	for b := c.bits; b != 0; b &= b - 1 {
+		key := modifierKeys[bitIndex(b&-b)]
+		mods[key] = modifierName(key)
+	}
+	return mods
+}
@@ -0,0 +1,76 @@
+// Package mock provides a scriptable fake keyboard producing the same event
+// stream as keyboard.Listen, for testing code that consumes keyboard events
+// without an input device.
+package mock
+
+import (
+	"sync"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+)
+
+// DefaultBufferSize is the number of events a Keyboard buffers before its
+// methods block until they are received.
+const DefaultBufferSize = 64
+
+// Keyboard is a fake keyboard whose events are scripted by calling its
+// methods and delivered on the channel returned by Events.
+type Keyboard struct {
+	events    chan keyboard.Event
+	closeOnce sync.Once
+}
+
+// NewKeyboard returns a Keyboard with no events queued.
+func NewKeyboard() *Keyboard {
+	return &Keyboard{events: make(chan keyboard.Event, DefaultBufferSize)}
+}
+
+// Events returns the channel delivering the scripted events, like the one
+// returned by keyboard.Listen. It is closed by Close.
+func (k *Keyboard) Events() <-chan keyboard.Event {
+	return k.events
+}
+
+// Press sends a Press event for the key with the given code (e.g., "KEY_A").
+func (k *Keyboard) Press(key string) {
+	k.send(key, keyboard.Press)
+}
+
+// Release sends a Release event for key.
+func (k *Keyboard) Release(key string) {
+	k.send(key, keyboard.Release)
+}
+
+// Hold sends a Hold event for key, as sent by the kernel's auto-repeat.
+func (k *Keyboard) Hold(key string) {
+	k.send(key, keyboard.Hold)
+}
+
+// Type presses and releases each of keys in turn, waiting interval before
+// every key after the first.
+func (k *Keyboard) Type(keys []string, interval time.Duration) {
+	for i, key := range keys {
+		if i > 0 && interval > 0 {
+			time.Sleep(interval)
+		}
+		k.Press(key)
+		k.Release(key)
+	}
+}
+
+// Disconnect sends a Disconnect event and closes the Events channel, like
+// keyboard.Listen does when the device is unplugged.
+func (k *Keyboard) Disconnect() {
+	k.send("", keyboard.Disconnect)
+	k.Close()
+}
+
+// Close closes the Events channel. No events may be sent afterwards.
+func (k *Keyboard) Close() {
+	k.closeOnce.Do(func() { close(k.events) })
+}
+
+func (k *Keyboard) send(key string, et keyboard.EventType) {
+	k.events <- keyboard.Event{Key: key, Type: et}
+}
@@ -0,0 +1,59 @@
+package mock_test
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+	"github.com/VinewZ/go-evdev-keyboard/mock"
+)
+
+func TestKeyboardDrivesManager(t *testing.T) {
+	m := keyboard.NewManager()
+	fired := 0
+	m.RegisterBinding("CTRL+A", func() { fired++ }, keyboard.SyncCallback())
+
+	k := mock.NewKeyboard()
+	go func() {
+		k.Press("KEY_LEFTCTRL")
+		k.Type([]string{"KEY_A", "KEY_A"}, 0)
+		k.Release("KEY_LEFTCTRL")
+		k.Disconnect()
+	}()
+	var last keyboard.Event
+	for ev := range k.Events() {
+		m.HandleEvent(ev)
+		last = ev
+	}
+	if fired != 2 {
+		t.Errorf("CTRL+A fired %d times, want 2", fired)
+	}
+	if last.Type != keyboard.Disconnect {
+		t.Errorf("last event = %v, want a Disconnect", last)
+	}
+}
+
+// TestPortable checks that the package does not depend on go-evdev on
+// platforms where it does not build.
+func TestPortable(t *testing.T) {
+	if testing.Short() {
+		t.Skip("listing dependencies runs the go command")
+	}
+	gotool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	for _, goos := range []string{"windows", "darwin"} {
+		cmd := exec.Command(gotool, "list", "-deps", "-test", ".")
+		cmd.Env = append(os.Environ(), "GOOS="+goos, "GOARCH=amd64", "CGO_ENABLED=0")
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("GOOS=%s go list: %v\n%s", goos, err, out)
+		}
+		if strings.Contains(string(out), "github.com/holoplot/go-evdev\n") {
+			t.Errorf("GOOS=%s: mock depends on go-evdev", goos)
+		}
+	}
+}
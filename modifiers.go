@@ -0,0 +1,94 @@
+package keyboard
+
+import "math/bits"
+
+// Modifiers is a bitmask of held modifier keys, for checking modifier state
+// without building combo strings or iterating maps.
+type Modifiers uint32
+
+// Bits of Modifiers, one per modifier key.
+const (
+	ModifierLeftCtrl Modifiers = 1 << iota
+	ModifierRightCtrl
+	ModifierLeftShift
+	ModifierRightShift
+	ModifierLeftAlt
+	ModifierRightAlt
+	ModifierLeftMeta
+	ModifierRightMeta
+)
+
+// Masks of Modifiers matching either key of a modifier.
+const (
+	ModifierCtrl  = ModifierLeftCtrl | ModifierRightCtrl
+	ModifierShift = ModifierLeftShift | ModifierRightShift
+	ModifierAlt   = ModifierLeftAlt | ModifierRightAlt
+	ModifierMeta  = ModifierLeftMeta | ModifierRightMeta
+)
+
+// modifierKeys lists the modifier key codes in the order of their
+// Modifiers bits.
+var modifierKeys = [...]string{
+	"KEY_LEFTCTRL", "KEY_RIGHTCTRL",
+	"KEY_LEFTSHIFT", "KEY_RIGHTSHIFT",
+	"KEY_LEFTALT", "KEY_RIGHTALT",
+	"KEY_LEFTMETA", "KEY_RIGHTMETA",
+}
+
+// modifierBits maps the modifier key codes to their Modifiers bits.
+var modifierBits = func() map[string]Modifiers {
+	m := make(map[string]Modifiers, len(modifierKeys))
+	for i, key := range modifierKeys {
+		m[key] = 1 << i
+	}
+	return m
+}()
+
+// bitIndex returns the position of the single bit set in bit, which is the
+// index of its key in modifierKeys.
+func bitIndex(bit Modifiers) int {
+	return bits.TrailingZeros32(uint32(bit))
+}
+
+// modifierNameBits maps the built-in modifier names to their Modifiers masks.
+var modifierNameBits = map[string]Modifiers{
+	"CTRL":  ModifierCtrl,
+	"SHIFT": ModifierShift,
+	"ALT":   ModifierAlt,
+	"META":  ModifierMeta,
+}
+
+// HasCtrl reports whether either CTRL key is held.
+func (m Modifiers) HasCtrl() bool { return m&ModifierCtrl != 0 }
+
+// HasShift reports whether either SHIFT key is held.
+func (m Modifiers) HasShift() bool { return m&ModifierShift != 0 }
+
+// HasAlt reports whether either ALT key is held.
+func (m Modifiers) HasAlt() bool { return m&ModifierAlt != 0 }
+
+// HasMeta reports whether either META key is held.
+func (m Modifiers) HasMeta() bool { return m&ModifierMeta != 0 }
+
+// ActiveModifiers returns the modifier keys currently held and acting as
+// their usual modifier, so KEY_RIGHTALT is left out after
+// TreatAltGrAsAlt(false), as are keys remapped by WithModifierAliases.
+// Other keys made modifiers by WithModifierAliases have no bit. It does not
+// take the Manager's lock.
+func (m *Manager) ActiveModifiers() Modifiers {
+	return Modifiers(m.modBits.Load())
+}
+
+// updateModifierBits records a press (down) or release of key in the
+// bitmask returned by ActiveModifiers. The caller must hold m.mu.
+func (m *Manager) updateModifierBits(key string, down bool) {
+	bit, ok := modifierBits[key]
+	if !ok {
+		return
+	}
+	mods := Modifiers(m.modBits.Load()) &^ bit
+	if name, mod := m.modifierOf(key); down && mod && name == modifierName(key) {
+		mods |= bit
+	}
+	m.modBits.Store(uint32(mods))
+}
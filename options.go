@@ -0,0 +1,100 @@
+package keyboard
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// WithSuppressRepeats enables suppression of repeated callback invocations
+// until keys are released, like calling SuppressRepeats.
+func WithSuppressRepeats() ManagerOption {
+	return func(m *Manager) {
+		m.suppressRepeats = true
+	}
+}
+
+// WithLastKeyFiresCombo makes a combo fire when its last key is pressed,
+// whether that is the trigger or a modifier. Without it, combos only fire
+// on a press of their trigger key, so holding A and then pressing CTRL does
+// not fire "CTRL+A". With it, that sequence fires "CTRL+A", and modifier
+// keys may be used as triggers (e.g., "CTRL+LEFTSHIFT").
+func WithLastKeyFiresCombo() ManagerOption {
+	return func(m *Manager) {
+		m.lastKeyFires = true
+	}
+}
+
+// DefaultMaxComboKeys is the number of non-modifier keys a combo may
+// contain unless set with WithMaxComboKeys, allowing combos such as "A+B".
+const DefaultMaxComboKeys = 2
+
+// WithMaxComboKeys sets how many non-modifier keys a combo may contain
+// (e.g., 3 for "A+S+D"), limiting the combos tried on each key press.
+// Registering combos with more keys fails. Values below 1 are treated as 1,
+// which allows only the trigger key.
+func WithMaxComboKeys(n int) ManagerOption {
+	return func(m *Manager) {
+		m.maxComboKeys = max(n, 1)
+	}
+}
+
+// UseModifierBitmask makes the Manager track the built-in modifier keys in
+// a bitmask instead of the maps holding the other pressed keys, saving map
+// operations for every modifier event and combo built. Keys remapped by
+// WithModifierAliases are still tracked in the maps. The modifier state
+// returned by ActiveModifiers is lock-free either way.
+func UseModifierBitmask() ManagerOption {
+	return func(m *Manager) {
+		m.keys.useBits = true
+	}
+}
+
+// IgnoreRepeatModifiers makes the Manager drop the Hold events the kernel
+// sends while a modifier key auto-repeats, before filters, the event
+// history or the pressed key state see them. Such events never fire
+// combos anyway. A modifier held for longer than WithStalePressTimeout is
+// then released even though it is still down.
+func IgnoreRepeatModifiers() ManagerOption {
+	return func(m *Manager) {
+		m.dropModRepeats = true
+	}
+}
+
+// WithLogger sets the logger receiving the Manager's diagnostics, such as
+// invalid bindings and slow callbacks. By default they are discarded.
+func WithLogger(l *slog.Logger) ManagerOption {
+	return func(m *Manager) {
+		if l != nil {
+			m.logger = l
+		}
+	}
+}
+
+// WithCallbackTimeout makes the Manager log a warning for every callback
+// that is still running after d, like calling SetCallbackTimeout.
+func WithCallbackTimeout(d time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.SetCallbackTimeout(d)
+	}
+}
+
+// WithModifierAliases declares additional modifier keys, mapping modifier
+// names to the key codes acting as them. Names may be new (e.g., "HYPER"
+// for "KEY_CAPSLOCK") or built-in (e.g., "CTRL" for "KEY_CAPSLOCK"), and
+// can then be used in combos like any other modifier. Unknown key names
+// are ignored.
+func WithModifierAliases(aliases map[string][]string) ManagerOption {
+	return func(m *Manager) {
+		for name, keys := range aliases {
+			name = strings.ToUpper(strings.TrimSpace(name))
+			for _, k := range keys {
+				if code := keyCode(k); code != "" {
+					m.modAliases[code] = name
+				} else {
+					m.logger.Warn("ignoring unknown modifier alias key", "modifier", name, "key", k)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package keyboard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// RegisterBindingPattern registers cb for every combo matched by pattern and
+// returns the ID shared by the resulting bindings. A pattern is a combo in
+// which "[lo-hi]" expands to a range of numbers or letters and "{a,b,c}" to
+// each listed alternative, so "F[1-12]" binds F1 to F12 and "CTRL+{A,B}"
+// binds CTRL+A and CTRL+B. cb receives the trigger key of the matched
+// combo (e.g., "F5"). It returns 0 and logs a warning if the pattern is
+// invalid.
+func (m *Manager) RegisterBindingPattern(pattern string, cb func(key string)) BindingID {
+	combos, err := expandPattern(pattern)
+	if err != nil {
+		m.logger.Warn("ignoring invalid binding pattern", "pattern", pattern, "error", err)
+		return 0
+	}
+	id, err := m.registerKeyCallbacks(combos, cb)
+	if err != nil {
+		m.logger.Warn("ignoring invalid binding pattern", "pattern", pattern, "error", err)
+		return 0
+	}
+	return id
+}
+
+// registerKeyCallbacks registers a binding for each of combos calling cb
+// with the combo's trigger key, all sharing a single ID.
+func (m *Manager) registerKeyCallbacks(combos []string, cb func(key string)) (BindingID, error) {
+	if cb == nil {
+		return 0, ErrNilCallback
+	}
+	specs := make([]BindingSpec, len(combos))
+	for i, combo := range combos {
+		norm, err := m.parseCombo(combo)
+		if err != nil {
+			return 0, err
+		}
+		key := comboTrigger(norm)
+		specs[i] = BindingSpec{Combo: combo, Callback: func() { cb(key) }}
+	}
+	return m.registerSpecs(specs)
+}
+
+// maxPatternCombos is the most combos a binding pattern may expand to,
+// and so the longest range it may contain. It is far above the number of
+// keys on any keyboard, and stops patterns such as "F[1-100000000]" from
+// allocating every combo before the key names are checked.
+const maxPatternCombos = 1024
+
+// expandPattern expands the "[lo-hi]" and "{a,b}" groups of pattern into the
+// combos they describe.
+func expandPattern(pattern string) ([]string, error) {
+	start := strings.IndexAny(pattern, "[{")
+	if start < 0 {
+		return []string{pattern}, nil
+	}
+	closing := "]"
+	if pattern[start] == '{' {
+		closing = "}"
+	}
+	end := strings.Index(pattern[start:], closing)
+	if end < 0 {
+		return nil, fmt.Errorf("%w: unterminated %q in pattern %q", ErrKeyNameInvalid, pattern[start], pattern)
+	}
+	end += start
+	inner := pattern[start+1 : end]
+
+	var alts []string
+	if closing == "}" {
+		alts = strings.Split(inner, ",")
+	} else {
+		var err error
+		if alts, err = expandRange(inner); err != nil {
+			return nil, fmt.Errorf("%w in pattern %q", err, pattern)
+		}
+	}
+	rest, err := expandPattern(pattern[end+1:])
+	if err != nil {
+		return nil, err
+	}
+	if len(alts)*len(rest) > maxPatternCombos {
+		return nil, fmt.Errorf("%w: pattern %q expands to more than %d combos", ErrKeyNameInvalid, pattern, maxPatternCombos)
+	}
+	combos := make([]string, 0, len(alts)*len(rest))
+	for _, alt := range alts {
+		for _, r := range rest {
+			combos = append(combos, pattern[:start]+strings.TrimSpace(alt)+r)
+		}
+	}
+	return combos, nil
+}
+
+// expandRange expands a "lo-hi" range of numbers (e.g., "1-12") or single
+// letters (e.g., "A-Z"), of at most maxPatternCombos values.
+func expandRange(r string) ([]string, error) {
+	lo, hi, ok := strings.Cut(r, "-")
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid range %q", ErrKeyNameInvalid, r)
+	}
+	lo, hi = strings.TrimSpace(lo), strings.TrimSpace(hi)
+	var out []string
+	if l, err := strconv.Atoi(lo); err == nil {
+		h, err := strconv.Atoi(hi)
+		if err != nil || h < l {
+			return nil, fmt.Errorf("%w: invalid range %q", ErrKeyNameInvalid, r)
+		}
+		if h-l >= maxPatternCombos {
+			return nil, fmt.Errorf("%w: range %q has more than %d values", ErrKeyNameInvalid, r, maxPatternCombos)
+		}
+		for i := l; i <= h; i++ {
+			out = append(out, strconv.Itoa(i))
+		}
+		return out, nil
+	}
+	if len(lo) != 1 || len(hi) != 1 || hi[0] < lo[0] {
+		return nil, fmt.Errorf("%w: invalid range %q", ErrKeyNameInvalid, r)
+	}
+	for c := int(lo[0]); c <= int(hi[0]); c++ {
+		out = append(out, string(rune(c)))
+	}
+	return out, nil
+}
+
+// RegisterBindingForKeys registers cb for each of keys combined with
+// modifiers, returning the ID shared by the resulting bindings. cb receives
+// the trigger key of the matched combo, so registering keys "A" to "Z" with
+// modifiers ["CTRL"] calls cb("A") for CTRL+A. It returns 0 and logs a
+// warning if any resulting combo is invalid.
+func (m *Manager) RegisterBindingForKeys(keys []string, modifiers []string, cb func(key string)) BindingID {
+	prefix := strings.Join(modifiers, "+")
+	combos := make([]string, len(keys))
+	for i, key := range keys {
+		if prefix == "" {
+			combos[i] = key
+		} else {
+			combos[i] = prefix + "+" + key
+		}
+	}
+	id, err := m.registerKeyCallbacks(combos, cb)
+	if err != nil {
+		m.logger.Warn("ignoring invalid bindings", "keys", keys, "modifiers", modifiers, "error", err)
+		return 0
+	}
+	return id
+}
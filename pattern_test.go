@@ -0,0 +1,51 @@
+package keyboard
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/exp/slices"
+)
+
+func TestExpandPattern(t *testing.T) {
+	for _, tc := range []struct {
+		pattern string
+		want    []string
+	}{
+		{"CTRL+A", []string{"CTRL+A"}},
+		{"F[1-3]", []string{"F1", "F2", "F3"}},
+		{"CTRL+{A, B}", []string{"CTRL+A", "CTRL+B"}},
+		{"{CTRL,ALT}+[x-y]", []string{"CTRL+x", "CTRL+y", "ALT+x", "ALT+y"}},
+	} {
+		got, err := expandPattern(tc.pattern)
+		if err != nil || !slices.Equal(got, tc.want) {
+			t.Errorf("expandPattern(%q) = %q, %v, want %q", tc.pattern, got, err, tc.want)
+		}
+	}
+}
+
+func TestExpandPatternLimits(t *testing.T) {
+	for _, pattern := range []string{
+		"F[1-100000000]",
+		"F[0-1024]",
+		"[1-100][1-100]",
+		"{A,B,C,D}+[1-300]",
+		"F[1-",
+		"F[3-1]",
+		"F[A-1]",
+	} {
+		if got, err := expandPattern(pattern); !errors.Is(err, ErrKeyNameInvalid) {
+			t.Errorf("expandPattern(%q) = %d combos, %v, want ErrKeyNameInvalid", pattern, len(got), err)
+		}
+	}
+	if got, err := expandPattern("F[1-1024]"); err != nil || len(got) != maxPatternCombos {
+		t.Errorf("expandPattern(F[1-1024]) = %d combos, %v, want %d", len(got), err, maxPatternCombos)
+	}
+	m := NewManager()
+	if id := m.RegisterBindingPattern("F[1-100000000]", func(string) {}); id != 0 {
+		t.Errorf("RegisterBindingPattern registered an oversized range as %d", id)
+	}
+	if allocs := testing.AllocsPerRun(1, func() { expandPattern("F[1-100000000]") }); allocs > 10 {
+		t.Errorf("rejecting an oversized range made %v allocations", allocs)
+	}
+}
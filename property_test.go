@@ -0,0 +1,209 @@
+package keyboard
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"golang.org/x/exp/slices"
+)
+
+// modifierPresses maps the modifier names to the keys producing them.
+var modifierPresses = map[string][]string{
+	"CTRL":  {"KEY_LEFTCTRL", "KEY_RIGHTCTRL"},
+	"SHIFT": {"KEY_LEFTSHIFT", "KEY_RIGHTSHIFT"},
+	"ALT":   {"KEY_LEFTALT"},
+	"META":  {"KEY_LEFTMETA", "KEY_RIGHTMETA"},
+}
+
+// comboKeyNames are the non-modifier keys used in generated combos, in
+// the various forms ParseCombo accepts.
+var comboKeyNames = []string{"A", "b", "KEY_C", "key_d", "1", "F5", "esc", "SPACE", "KP1", "RAW_500"}
+
+// genCombo is a randomly generated valid combo: some modifiers, an optional
+// held key and a trigger key, in random order and spelling.
+type genCombo struct {
+	mods []string // modifier names in the order written
+	keys []string // non-modifier keys in the order pressed, the trigger last
+}
+
+// Generate implements quick.Generator.
+func (genCombo) Generate(r *rand.Rand, _ int) reflect.Value {
+	var c genCombo
+	for _, i := range r.Perm(len(modifierOrder))[:r.Intn(len(modifierOrder)+1)] {
+		c.mods = append(c.mods, modifierOrder[i])
+	}
+	for _, i := range r.Perm(len(comboKeyNames))[:1+r.Intn(2)] {
+		c.keys = append(c.keys, comboKeyNames[i])
+	}
+	return reflect.ValueOf(c)
+}
+
+// spell writes c with random letter case and spacing.
+func (c genCombo) spell(r *rand.Rand) string {
+	parts := append(slices.Clone(c.mods), c.keys...)
+	for i, p := range parts {
+		if r.Intn(2) == 0 {
+			p = strings.ToLower(p)
+		}
+		parts[i] = strings.Repeat(" ", r.Intn(2)) + p + strings.Repeat(" ", r.Intn(2))
+	}
+	return strings.Join(parts, "+")
+}
+
+// events returns the key presses producing c, with the modifiers pressed
+// in random order with random left or right keys.
+func (c genCombo) events(r *rand.Rand) []Event {
+	var evs []Event
+	for _, i := range r.Perm(len(c.mods)) {
+		keys := modifierPresses[c.mods[i]]
+		evs = append(evs, press(keys[r.Intn(len(keys))]))
+	}
+	for _, k := range c.keys {
+		evs = append(evs, press(keyCode(k)))
+	}
+	return evs
+}
+
+func quickConfig(t *testing.T) *quick.Config {
+	if testing.Short() {
+		return &quick.Config{MaxCount: 100}
+	}
+	return &quick.Config{MaxCount: 2000}
+}
+
+func TestParseComboIdempotent(t *testing.T) {
+	valid := func(c genCombo, seed int64) bool {
+		norm, err := ParseCombo(c.spell(rand.New(rand.NewSource(seed))))
+		if err != nil {
+			t.Logf("ParseCombo(%v): %v", c, err)
+			return false
+		}
+		again, err := ParseCombo(norm)
+		return err == nil && again == norm
+	}
+	if err := quick.Check(valid, quickConfig(t)); err != nil {
+		t.Error(err)
+	}
+	arbitrary := func(s string) bool {
+		norm, err := ParseCombo(s)
+		if err != nil {
+			return true
+		}
+		again, err := ParseCombo(norm)
+		return err == nil && again == norm
+	}
+	if err := quick.Check(arbitrary, quickConfig(t)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParseComboRoundTrip(t *testing.T) {
+	// the combo built from pressed keys must equal the registered one
+	roundTrip := func(c genCombo, seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		norm, err := ParseCombo(c.spell(r))
+		if err != nil {
+			return false
+		}
+		var mods, keys []string
+		for _, ev := range c.events(r) {
+			if isModifier(ev.Key) {
+				mods = append(mods, modifierName(ev.Key))
+			} else {
+				keys = append(keys, keyName(ev.Key))
+			}
+		}
+		return joinCombo(mods, strings.Join(keys, "+")) == norm
+	}
+	if err := quick.Check(roundTrip, quickConfig(t)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegistrationOrderIndependent(t *testing.T) {
+	// a combo fires once whatever order its modifiers are written and
+	// pressed in
+	fires := func(c genCombo, seed int64) bool {
+		r := rand.New(rand.NewSource(seed))
+		combo := c.spell(r)
+		m, fired := newCountingManager(t, []string{combo})
+		m.HandleEvent(c.events(r)...)
+		return fired[combo] == 1
+	}
+	if err := quick.Check(fires, quickConfig(t)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestComboCache(t *testing.T) {
+	const combo = "shift + Ctrl+f7"
+	norm, err := ParseCombo(combo)
+	if err != nil || norm != "CTRL+SHIFT+F7" {
+		t.Fatalf("ParseCombo(%q) = %q, %v", combo, norm, err)
+	}
+	if v, ok := comboCache.Load(combo); !ok || v != norm {
+		t.Errorf("cache holds %v, %v for %q, want %q", v, ok, combo, norm)
+	}
+	if again, _ := ParseCombo(combo); again != norm {
+		t.Errorf("cached ParseCombo(%q) = %q, want %q", combo, again, norm)
+	}
+	if allocs := testing.AllocsPerRun(100, func() { ParseCombo(combo) }); allocs != 0 {
+		t.Errorf("cached ParseCombo: %v allocations, want 0", allocs)
+	}
+
+	const invalid = "CTRL+NOPE+F7"
+	for range 2 {
+		if _, err := ParseCombo(invalid); err == nil {
+			t.Fatalf("ParseCombo(%q) succeeded", invalid)
+		}
+	}
+	if _, ok := comboCache.Load(invalid); ok {
+		t.Errorf("invalid combo %q cached", invalid)
+	}
+}
+
+// genEvents is a random sequence of key events on modifiers, ordinary keys
+// and a key without a name.
+type genEvents []Event
+
+// Generate implements quick.Generator.
+func (genEvents) Generate(r *rand.Rand, size int) reflect.Value {
+	keys := []string{"KEY_LEFTCTRL", "KEY_RIGHTCTRL", "KEY_LEFTSHIFT", "KEY_RIGHTALT", "KEY_LEFTMETA", "KEY_A", "KEY_B", "KEY_F5", "KEY_KP1", "RAW_500"}
+	types := []EventType{Press, Press, Release, Hold}
+	evs := make(genEvents, r.Intn(size+1))
+	for i := range evs {
+		evs[i] = Event{Key: keys[r.Intn(len(keys))], Type: types[r.Intn(len(types))]}
+	}
+	return reflect.ValueOf(evs)
+}
+
+func TestPressedCombosNormalized(t *testing.T) {
+	// every combo built from pressed keys is what ParseCombo makes of it;
+	// checkCombo additionally asserts this for each combo built while
+	// handling the events
+	normalized := func(evs genEvents, suppress bool) bool {
+		var opts []ManagerOption
+		if suppress {
+			opts = append(opts, WithSuppressRepeats())
+		}
+		m := NewManager(opts...)
+		for _, ev := range evs {
+			m.HandleEvent(ev)
+			combo := m.InferCombo()
+			if combo == "" {
+				continue
+			}
+			if norm, err := ParseCombo(combo); err != nil || norm != combo {
+				t.Logf("after %v: built %q, ParseCombo = %q, %v", evs, combo, norm, err)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(normalized, quickConfig(t)); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package remote streams keyboard events over TCP, so that a physical
+// keyboard attached to one machine can drive a Manager on another. The
+// server sends every event as a JSON object on its own line to all
+// connected clients. The stream carries every key typed, so by default it
+// is only served on the loopback interface; see ServeTCP.
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+)
+
+// WriteTimeout is how long ServeTCP waits for a client to accept an event
+// before disconnecting it.
+const WriteTimeout = 5 * time.Second
+
+// ListenTCP connects to the event server at addr and returns a channel
+// streaming the events it sends. Like keyboard.Listen, it sends a
+// Disconnect event and closes the channel when the connection fails or the
+// server closes it. Once ctx is done, the connection is closed and the
+// channel is closed without a Disconnect event.
+func ListenTCP(ctx context.Context, addr string) (<-chan keyboard.Event, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	stop := context.AfterFunc(ctx, func() { conn.Close() })
+	out := make(chan keyboard.Event)
+	go func() {
+		defer close(out)
+		defer conn.Close()
+		defer stop()
+		dec := json.NewDecoder(conn)
+		for {
+			var ev keyboard.Event
+			if err := dec.Decode(&ev); err != nil {
+				if ctx.Err() == nil {
+					out <- keyboard.Event{Type: keyboard.Disconnect}
+				}
+				return
+			}
+			select {
+			case out <- ev:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// ClientBufferSize is the number of events ServeTCP queues for each client.
+// Clients falling further behind are disconnected, so that a slow client
+// does not hold up the others or the event source.
+const ClientBufferSize = 256
+
+// ServeOption configures ServeTCP.
+type ServeOption func(*serveConfig)
+
+// serveConfig holds the settings applied by ServeOptions.
+type serveConfig struct {
+	authorize func(conn net.Conn) bool
+}
+
+// WithAuthorizer sets the function deciding whether a newly connected
+// client may receive events, e.g. by checking its address against an
+// allowlist or reading a token from it. Rejected connections are closed.
+// authorize runs in a goroutine of its own for each connection, so it may
+// block, but should set a deadline on conn when reading from it. The
+// default is LoopbackOnly.
+func WithAuthorizer(authorize func(conn net.Conn) bool) ServeOption {
+	return func(c *serveConfig) {
+		if authorize != nil {
+			c.authorize = authorize
+		}
+	}
+}
+
+// LoopbackOnly accepts only clients connecting from a loopback address,
+// i.e. from the same machine.
+func LoopbackOnly(conn net.Conn) bool {
+	addr, ok := conn.RemoteAddr().(*net.TCPAddr)
+	return ok && addr.IP.IsLoopback()
+}
+
+// ServeTCP listens on addr and sends every event received from src to all
+// connected clients, such as those of ListenTCP, until src is closed or ctx
+// is done. It returns nil once src is closed, ctx.Err() once ctx is done,
+// and an error if addr cannot be listened on.
+//
+// The events are every key typed on the source keyboard, including
+// passwords, and are sent unencrypted: anyone able to connect can log
+// them. Therefore an addr without a host (e.g., ":9000") listens on the
+// loopback interface only, and by default only clients connecting from
+// the loopback interface receive events. To serve other machines, listen
+// on their interface, set an authorizer with WithAuthorizer, and tunnel the
+// connections through an encrypted transport such as SSH or a VPN.
+//
+// Each client has its own queue of ClientBufferSize events, written by a
+// goroutine of its own. Clients whose queue is full, or that fail to accept
+// an event within WriteTimeout, are disconnected.
+func ServeTCP(ctx context.Context, src <-chan keyboard.Event, addr string, opts ...ServeOption) error {
+	cfg := serveConfig{authorize: LoopbackOnly}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if host, port, err := net.SplitHostPort(addr); err == nil && host == "" {
+		addr = net.JoinHostPort("127.0.0.1", port)
+	}
+	var lc net.ListenConfig
+	ln, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	h := newHub()
+	defer func() {
+		ln.Close()
+		h.close()
+	}()
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				if cfg.authorize(conn) {
+					h.add(conn)
+				} else {
+					conn.Close()
+				}
+			}()
+		}
+	}()
+
+	for {
+		select {
+		case ev, ok := <-src:
+			if !ok {
+				return nil
+			}
+			h.broadcast(ev)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// client is a connection receiving events from a hub.
+type client struct {
+	conn   net.Conn
+	events chan keyboard.Event // queued events, closed when the client is dropped
+	done   chan struct{}       // closed once the writer has stopped
+}
+
+// write sends the queued events to the client until its queue is closed or
+// a write fails.
+func (c *client) write() {
+	defer close(c.done)
+	defer c.conn.Close()
+	enc := json.NewEncoder(c.conn)
+	for ev := range c.events {
+		c.conn.SetWriteDeadline(time.Now().Add(WriteTimeout))
+		if err := enc.Encode(ev); err != nil {
+			return
+		}
+	}
+}
+
+// hub fans events out to the connected clients.
+type hub struct {
+	mu      sync.Mutex
+	clients map[*client]bool
+	closed  bool
+}
+
+func newHub() *hub {
+	return &hub{clients: make(map[*client]bool)}
+}
+
+// add starts sending events to conn, or closes it if h is closed.
+func (h *hub) add(conn net.Conn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		conn.Close()
+		return
+	}
+	c := &client{conn: conn, events: make(chan keyboard.Event, ClientBufferSize), done: make(chan struct{})}
+	h.clients[c] = true
+	go c.write()
+}
+
+// broadcast queues ev for every client without blocking, dropping clients
+// whose queue is full or whose writer has stopped.
+func (h *hub) broadcast(ev keyboard.Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for c := range h.clients {
+		select {
+		case <-c.done:
+			h.drop(c)
+			continue
+		default:
+		}
+		select {
+		case c.events <- ev:
+		default:
+			h.drop(c)
+		}
+	}
+}
+
+// drop disconnects c. The caller must hold h.mu.
+func (h *hub) drop(c *client) {
+	delete(h.clients, c)
+	close(c.events)
+	c.conn.Close()
+}
+
+// close disconnects all clients and makes add close new connections.
+func (h *hub) close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.closed = true
+	for c := range h.clients {
+		h.drop(c)
+	}
+}
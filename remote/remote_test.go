@@ -0,0 +1,150 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+)
+
+// freeAddr returns a loopback address with a free TCP port.
+func freeAddr(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	return ln.Addr().String()
+}
+
+// serve runs ServeTCP on addr until the test ends and returns the channel
+// feeding it.
+func serve(t *testing.T, addr string, opts ...ServeOption) chan<- keyboard.Event {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	src := make(chan keyboard.Event)
+	done := make(chan error, 1)
+	go func() { done <- ServeTCP(ctx, src, addr, opts...) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return src
+}
+
+// dial connects to addr with ListenTCP, retrying until the server is up.
+func dial(t *testing.T, ctx context.Context, addr string) <-chan keyboard.Event {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		events, err := ListenTCP(ctx, addr)
+		if err == nil {
+			return events
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("ListenTCP: %v", err)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// sendUntil sends ev to src until it arrives on events, since clients
+// only receive events sent after they were accepted.
+func sendUntil(t *testing.T, src chan<- keyboard.Event, events <-chan keyboard.Event, ev keyboard.Event) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		src <- ev
+		select {
+		case got := <-events:
+			if got != ev {
+				t.Fatalf("received %v, want %v", got, ev)
+			}
+			return
+		case <-time.After(10 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("%v not received", ev)
+		}
+	}
+}
+
+func TestServeTCP(t *testing.T) {
+	addr := freeAddr(t)
+	src := serve(t, addr)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := dial(t, ctx, addr)
+	sendUntil(t, src, events, keyboard.Event{Key: "KEY_A", Type: keyboard.Press})
+	src <- keyboard.Event{Key: "KEY_A", Type: keyboard.Release}
+	got := <-events
+	for got.Type == keyboard.Press {
+		// repeated by sendUntil
+		got = <-events
+	}
+	if got != (keyboard.Event{Key: "KEY_A", Type: keyboard.Release}) {
+		t.Errorf("received %v, want RELEASE A", got)
+	}
+}
+
+func TestServeTCPAuthorizer(t *testing.T) {
+	addr := freeAddr(t)
+	serve(t, addr, WithAuthorizer(func(net.Conn) bool { return false }))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events := dial(t, ctx, addr)
+	select {
+	case ev := <-events:
+		if ev.Type != keyboard.Disconnect {
+			t.Errorf("rejected client received %v, want a Disconnect", ev)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("rejected client was not disconnected")
+	}
+}
+
+func TestServeTCPDefaultsToLoopback(t *testing.T) {
+	_, port, _ := net.SplitHostPort(freeAddr(t))
+	serve(t, ":"+port)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dial(t, ctx, "127.0.0.1:"+port)
+}
+
+func TestHubDropsSlowClient(t *testing.T) {
+	h := newHub()
+	defer h.close()
+	stalled, stalledPeer := net.Pipe()
+	good, goodPeer := net.Pipe()
+	h.add(stalled)
+	h.add(good)
+
+	dec := json.NewDecoder(goodPeer)
+	for i := range 2 * ClientBufferSize {
+		ev := keyboard.Event{Key: "KEY_A", Type: keyboard.Press}
+		start := time.Now()
+		h.broadcast(ev)
+		if d := time.Since(start); d > 100*time.Millisecond {
+			t.Fatalf("broadcast %d blocked for %v", i, d)
+		}
+		var got keyboard.Event
+		if err := dec.Decode(&got); err != nil || got != ev {
+			t.Fatalf("event %d: received %v, %v", i, got, err)
+		}
+	}
+
+	read := make(chan error, 1)
+	go func() {
+		_, err := io.ReadAll(stalledPeer)
+		read <- err
+	}()
+	select {
+	case <-read:
+	case <-time.After(time.Second):
+		t.Fatal("stalled client was not disconnected")
+	}
+}
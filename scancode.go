@@ -0,0 +1,154 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"unsafe"
+
+	"github.com/holoplot/go-evdev"
+	"golang.org/x/sys/unix"
+)
+
+// keymapEntry mirrors the kernel's struct input_keymap_entry, the argument
+// of the EVIOCGKEYCODE_V2 and EVIOCSKEYCODE_V2 ioctls.
+type keymapEntry struct {
+	flags    uint8
+	len      uint8
+	index    uint16
+	keycode  uint32
+	scancode [32]byte
+}
+
+// keymapByIndex is INPUT_KEYMAP_BY_INDEX, selecting keymap entries by index
+// rather than by scancode.
+const keymapByIndex = 1
+
+// ScanCodeMap maps the hardware scancodes of a device, as reported by
+// EV_MSC MSC_SCAN events, to the evdev key codes the kernel translates
+// them to.
+type ScanCodeMap map[uint32]evdev.EvCode
+
+// KeyName returns the evdev code name (e.g., "KEY_A") of the key scanCode
+// is mapped to, or an empty string if it is not mapped.
+func (s ScanCodeMap) KeyName(scanCode uint32) string {
+	code, ok := s[scanCode]
+	if !ok {
+		return ""
+	}
+	return keyCodeName(code)
+}
+
+// LoadScanCodeMap reads the scancode to key code mapping of the input device
+// at path using EVIOCGKEYCODE. Entries with scancodes wider than 32 bits are
+// skipped.
+func LoadScanCodeMap(path string) (ScanCodeMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer f.Close()
+	req := evioc(iocRead, 0x04, unsafe.Sizeof(keymapEntry{}))
+	m := make(ScanCodeMap)
+	for i := 0; i <= 0xffff; i++ {
+		e := keymapEntry{flags: keymapByIndex, index: uint16(i)}
+		if err := ioctl(f.Fd(), req, unsafe.Pointer(&e)); err != nil {
+			// the kernel reports the end of the keymap with EINVAL
+			if errors.Is(err, unix.EINVAL) {
+				break
+			}
+			return nil, fmt.Errorf("%w %s: reading keymap: %w", ErrDeviceRead, path, err)
+		}
+		if e.len == 0 || e.len > 4 {
+			continue
+		}
+		var sc [4]byte
+		copy(sc[:], e.scancode[:e.len])
+		m[binary.NativeEndian.Uint32(sc[:])] = evdev.EvCode(e.keycode)
+	}
+	return m, nil
+}
+
+var (
+	origKeyCodesMu sync.Mutex
+	origKeyCodes   = map[string]map[uint32]evdev.EvCode{} // device path to scancodes to key codes before remapping
+)
+
+// WriteKeyCodeMapping maps scanCode to keyCode on the input device at path
+// using EVIOCSKEYCODE. The mapping is applied by the kernel, so it affects
+// every reader of the device until it is reset or the device is removed.
+func WriteKeyCodeMapping(path string, scanCode uint32, keyCode evdev.EvCode) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer f.Close()
+
+	origKeyCodesMu.Lock()
+	defer origKeyCodesMu.Unlock()
+	orig := origKeyCodes[path]
+	if _, ok := orig[scanCode]; !ok {
+		code, err := readKeyCode(f, scanCode)
+		if err != nil {
+			return fmt.Errorf("%w %s: reading keymap: %w", ErrDeviceRead, path, err)
+		}
+		if orig == nil {
+			orig = make(map[uint32]evdev.EvCode)
+			origKeyCodes[path] = orig
+		}
+		orig[scanCode] = code
+	}
+	if err := writeKeyCode(f, scanCode, keyCode); err != nil {
+		return fmt.Errorf("%w %s: writing keymap: %w", ErrDeviceWrite, path, err)
+	}
+	return nil
+}
+
+// ResetKeyCodeMapping restores the key code scanCode was mapped to on the
+// input device at path before the first WriteKeyCodeMapping call for it.
+// It returns an error if the mapping was not changed by this process.
+func ResetKeyCodeMapping(path string, scanCode uint32) error {
+	origKeyCodesMu.Lock()
+	defer origKeyCodesMu.Unlock()
+	code, ok := origKeyCodes[path][scanCode]
+	if !ok {
+		return fmt.Errorf("%w: scancode %#x of %s was not remapped", ErrBindingNotFound, scanCode, path)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("%w %s: %w", ErrDeviceOpen, path, err)
+	}
+	defer f.Close()
+	if err := writeKeyCode(f, scanCode, code); err != nil {
+		return fmt.Errorf("%w %s: writing keymap: %w", ErrDeviceWrite, path, err)
+	}
+	delete(origKeyCodes[path], scanCode)
+	return nil
+}
+
+// scanCodeEntry returns the keymap entry selecting scanCode.
+func scanCodeEntry(scanCode uint32) keymapEntry {
+	e := keymapEntry{len: 4}
+	binary.NativeEndian.PutUint32(e.scancode[:4], scanCode)
+	return e
+}
+
+// readKeyCode returns the key code scanCode is mapped to on f.
+func readKeyCode(f *os.File, scanCode uint32) (evdev.EvCode, error) {
+	e := scanCodeEntry(scanCode)
+	if err := ioctl(f.Fd(), evioc(iocRead, 0x04, unsafe.Sizeof(e)), unsafe.Pointer(&e)); err != nil {
+		return 0, err
+	}
+	return evdev.EvCode(e.keycode), nil
+}
+
+// writeKeyCode maps scanCode to keyCode on f.
+func writeKeyCode(f *os.File, scanCode uint32, keyCode evdev.EvCode) error {
+	e := scanCodeEntry(scanCode)
+	e.keycode = uint32(keyCode)
+	return ioctl(f.Fd(), evioc(iocWrite, 0x04, unsafe.Sizeof(e)), unsafe.Pointer(&e))
+}
@@ -0,0 +1,183 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/holoplot/go-evdev"
+	"golang.org/x/exp/slices"
+	"golang.org/x/sys/unix"
+)
+
+// busVirtual is the BUS_VIRTUAL bus type of devices created in software,
+// e.g. through uinput.
+const busVirtual = 0x06
+
+// ScannedDevice describes an input device considered by a KeyboardScanner.
+type ScannedDevice struct {
+	DeviceInfo
+	// Capabilities lists the event types the device supports.
+	Capabilities []evdev.EvType
+	// Phys is the physical location of the device, empty for most virtual
+	// devices.
+	Phys string
+}
+
+// DeviceLister lists the input devices a KeyboardScanner filters. The
+// default lists the evdev devices in /dev/input that can be opened, and
+// fails with ErrDevicePermission if access to all of them is denied; tests
+// may provide fakes.
+type DeviceLister interface {
+	ListDevices() ([]ScannedDevice, error)
+}
+
+// evdevLister is the DeviceLister of the evdev devices in /dev/input.
+type evdevLister struct{}
+
+// ListDevices returns the evdev devices that can be opened, skipping
+// others, e.g. for lack of permissions.
+func (evdevLister) ListDevices() ([]ScannedDevice, error) {
+	paths, err := evdev.ListDevicePaths()
+	if err != nil {
+		return nil, fmt.Errorf("%w: listing devices: %w", ErrDeviceRead, err)
+	}
+	var devs []ScannedDevice
+	var denied error // first permission error, if no device could be opened
+	opened := false
+	for _, p := range paths {
+		dev, err := evdev.Open(p.Path)
+		if err != nil {
+			if denied == nil && errors.Is(err, unix.EACCES) {
+				denied = openError(p.Path, err)
+			}
+			continue
+		}
+		opened = true
+		info, err := deviceInfo(p.Path, dev)
+		if err == nil {
+			phys, _ := dev.PhysicalLocation()
+			devs = append(devs, ScannedDevice{DeviceInfo: info, Capabilities: dev.CapableTypes(), Phys: phys})
+		}
+		dev.Close()
+	}
+	if !opened && denied != nil {
+		return nil, denied
+	}
+	return devs, nil
+}
+
+// KeyboardScanner finds input devices matching a set of filters, built
+// fluently, e.g.
+// NewScanner().RequireName("(?i)keyboard").RequireCapability(evdev.EV_KEY).ExcludeVirtual().Scan().
+type KeyboardScanner struct {
+	lister  DeviceLister
+	filters []func(ScannedDevice) bool
+	err     error // first invalid filter
+}
+
+// NewScanner returns a KeyboardScanner listing the evdev devices in
+// /dev/input, without filters.
+func NewScanner() *KeyboardScanner {
+	return &KeyboardScanner{lister: evdevLister{}}
+}
+
+// WithLister makes s list devices with l instead of evdev.
+func (s *KeyboardScanner) WithLister(l DeviceLister) *KeyboardScanner {
+	s.lister = l
+	return s
+}
+
+// RequireName keeps devices whose name matches the regular expression
+// pattern. An invalid pattern makes Scan fail.
+func (s *KeyboardScanner) RequireName(pattern string) *KeyboardScanner {
+	return s.requireMatch("name", pattern, func(d ScannedDevice) string { return d.Name })
+}
+
+// WithPhysPath keeps devices whose physical location matches the regular
+// expression pattern (e.g., "^usb-0000:00:14.0-3/" for the devices on one
+// USB port), for telling apart keyboards with the same name and IDs. An
+// invalid pattern makes Scan fail.
+func (s *KeyboardScanner) WithPhysPath(pattern string) *KeyboardScanner {
+	return s.requireMatch("physical path", pattern, func(d ScannedDevice) string { return d.Phys })
+}
+
+// requireMatch keeps devices for which field returns a string matching the
+// regular expression pattern, recording an error naming what for an
+// invalid pattern.
+func (s *KeyboardScanner) requireMatch(what, pattern string, field func(ScannedDevice) string) *KeyboardScanner {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		if s.err == nil {
+			s.err = fmt.Errorf("%w: device %s pattern: %w", ErrInvalidArgument, what, err)
+		}
+		return s
+	}
+	return s.Require(func(d ScannedDevice) bool { return re.MatchString(field(d)) })
+}
+
+// RequireCapability keeps devices supporting events of type t.
+func (s *KeyboardScanner) RequireCapability(t evdev.EvType) *KeyboardScanner {
+	return s.Require(func(d ScannedDevice) bool { return slices.Contains(d.Capabilities, t) })
+}
+
+// ExcludeVirtual drops devices created in software, recognized by the
+// BUS_VIRTUAL bus type or an empty physical location.
+func (s *KeyboardScanner) ExcludeVirtual() *KeyboardScanner {
+	return s.Require(func(d ScannedDevice) bool { return d.BusType != busVirtual && d.Phys != "" })
+}
+
+// Require keeps devices for which keep returns true.
+func (s *KeyboardScanner) Require(keep func(ScannedDevice) bool) *KeyboardScanner {
+	s.filters = append(s.filters, keep)
+	return s
+}
+
+// Scan lists the devices and returns those passing all filters, in the
+// order they were listed.
+func (s *KeyboardScanner) Scan() ([]DeviceInfo, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	devs, err := s.lister.ListDevices()
+	if err != nil {
+		return nil, err
+	}
+	var infos []DeviceInfo
+	for _, d := range devs {
+		ok := true
+		for _, keep := range s.filters {
+			if !keep(d) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			infos = append(infos, d.DeviceInfo)
+		}
+	}
+	return infos, nil
+}
+
+// keyboardScanner returns a scanner for devices supporting key and repeat
+// events, as keyboards do.
+func keyboardScanner() *KeyboardScanner {
+	return NewScanner().RequireCapability(evdev.EV_KEY).RequireCapability(evdev.EV_REP)
+}
+
+// first returns the path of the first device found by s, or
+// ErrNoKeyboardFound if there is none. Errors listing the devices or of
+// invalid filters are returned unchanged, so that they are not mistaken for
+// a keyboard that is merely not connected yet.
+func (s *KeyboardScanner) first() (string, error) {
+	infos, err := s.Scan()
+	if err != nil {
+		return "", err
+	}
+	if len(infos) == 0 {
+		return "", ErrNoKeyboardFound
+	}
+	return infos[0].Path, nil
+}
@@ -0,0 +1,47 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeLister is a DeviceLister returning fixed devices or an error.
+type fakeLister struct {
+	devs []ScannedDevice
+	err  error
+}
+
+func (l fakeLister) ListDevices() ([]ScannedDevice, error) { return l.devs, l.err }
+
+func TestScannerFirstErrors(t *testing.T) {
+	listErr := errors.New("no /dev/input")
+	kbd := ScannedDevice{DeviceInfo: DeviceInfo{Path: "/dev/input/event3", Name: "USB Keyboard"}}
+	for _, tc := range []struct {
+		name     string
+		scanner  *KeyboardScanner
+		want     error
+		notFound bool
+	}{
+		{"found", NewScanner().WithLister(fakeLister{devs: []ScannedDevice{kbd}}), nil, false},
+		{"none connected", NewScanner().WithLister(fakeLister{}), ErrNoKeyboardFound, true},
+		{"none matching", NewScanner().WithLister(fakeLister{devs: []ScannedDevice{kbd}}).RequireName("mouse"), ErrNoKeyboardFound, true},
+		{"listing fails", NewScanner().WithLister(fakeLister{err: listErr}), listErr, false},
+		{"permission denied", NewScanner().WithLister(fakeLister{err: ErrDevicePermission}), ErrDevicePermission, false},
+		{"invalid pattern", NewScanner().WithLister(fakeLister{devs: []ScannedDevice{kbd}}).RequireName("("), ErrInvalidArgument, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path, err := tc.scanner.first()
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("first() error = %v, want %v", err, tc.want)
+			}
+			if got := errors.Is(err, ErrNoKeyboardFound); got != tc.notFound {
+				t.Errorf("errors.Is(%v, ErrNoKeyboardFound) = %t, want %t", err, got, tc.notFound)
+			}
+			if err == nil && path != kbd.Path {
+				t.Errorf("first() = %q, want %q", path, kbd.Path)
+			}
+		})
+	}
+}
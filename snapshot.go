@@ -0,0 +1,144 @@
+package keyboard
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// LockState is the state of the keyboard lock LEDs, as returned by
+// LEDState.
+type LockState struct {
+	CapsLock   bool `json:"caps_lock,omitempty"`
+	NumLock    bool `json:"num_lock,omitempty"`
+	ScrollLock bool `json:"scroll_lock,omitempty"`
+}
+
+// ManagerSnapshot is the configuration of a Manager: its bindings, without
+// callbacks, disabled groups and settings, along with the lock state. It can
+// be encoded as JSON to persist hotkey configuration and applied again with
+// Restore.
+type ManagerSnapshot struct {
+	// Bindings lists the registered key bindings, ordered by combo and then
+	// by priority, with normalized combos and no callbacks.
+	Bindings []BindingSpec `json:"bindings"`
+	// DisabledGroups lists the groups disabled with DisableGroup, sorted.
+	DisabledGroups []string `json:"disabled_groups,omitempty"`
+	// SuppressRepeats is set by SuppressRepeats or WithSuppressRepeats.
+	SuppressRepeats bool `json:"suppress_repeats,omitempty"`
+	// IgnoreAltGr is set by TreatAltGrAsAlt(false).
+	IgnoreAltGr bool `json:"ignore_altgr,omitempty"`
+	// LastKeyFires is set by WithLastKeyFiresCombo.
+	LastKeyFires bool `json:"last_key_fires,omitempty"`
+	// MaxComboKeys is set by WithMaxComboKeys.
+	MaxComboKeys int `json:"max_combo_keys,omitempty"`
+	// ChordWindow is set by SetChordWindow.
+	ChordWindow time.Duration `json:"chord_window,omitempty"`
+	// CallbackTimeout is set by SetCallbackTimeout.
+	CallbackTimeout time.Duration `json:"callback_timeout,omitempty"`
+	// Locks is the lock state when the snapshot was taken. Restore ignores
+	// it: the lock state belongs to the keyboard and the system, and
+	// setting the LEDs would not change it.
+	Locks LockState `json:"locks"`
+}
+
+// Snapshot returns the current configuration of the Manager. Axis bindings
+// and options without a field in ManagerSnapshot, such as modifier aliases,
+// are not included.
+func (m *Manager) Snapshot() ManagerSnapshot {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	snap := ManagerSnapshot{
+		SuppressRepeats: m.suppressRepeats,
+		IgnoreAltGr:     m.ignoreAltGr,
+		LastKeyFires:    m.lastKeyFires,
+		MaxComboKeys:    m.maxComboKeys,
+		ChordWindow:     m.chordWindow,
+		CallbackTimeout: m.CallbackTimeout(),
+		Locks:           LockState{m.leds.caps, m.leds.num, m.leds.scroll},
+	}
+	combos := make([]string, 0, len(m.bindings))
+	for combo := range m.bindings {
+		combos = append(combos, combo)
+	}
+	slices.Sort(combos)
+	for _, combo := range combos {
+		for _, b := range visibleBindings(m.bindings[combo]) {
+			spec := b.spec
+			spec.Combo, spec.Callback, spec.OnRelease = combo, nil, nil
+			snap.Bindings = append(snap.Bindings, spec)
+		}
+	}
+	for g, off := range m.disabledGroups {
+		if off {
+			snap.DisabledGroups = append(snap.DisabledGroups, g)
+		}
+	}
+	slices.Sort(snap.DisabledGroups)
+	return snap
+}
+
+// callbackRegistry maps normalized combos to the callbacks Restore gives
+// their bindings.
+type callbackRegistry map[string]BindingCallback
+
+// SetCallbackRegistry sets the callbacks Restore gives the bindings it
+// re-creates, keyed by combo. Combos are normalized, so "ctrl+a" provides
+// the callback for "CTRL+A".
+func (m *Manager) SetCallbackRegistry(callbacks map[string]BindingCallback) {
+	registry := make(callbackRegistry, len(callbacks))
+	for combo, cb := range callbacks {
+		if norm, err := m.parseCombo(combo); err == nil {
+			registry[norm] = cb
+		} else {
+			m.logger.Warn("ignoring invalid callback registry combo", "combo", combo, "error", err)
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.registry = registry
+}
+
+// Restore replaces the key bindings, disabled groups and settings of the
+// Manager with those of snap, leaving the lock state unchanged. Each binding gets the callback registered for
+// its combo with SetCallbackRegistry. Bindings without a callback or whose
+// combo is invalid are skipped, and the returned error lists them.
+func (m *Manager) Restore(snap ManagerSnapshot) error {
+	m.UnregisterAll()
+	m.mu.Lock()
+	m.suppressRepeats = snap.SuppressRepeats
+	m.ignoreAltGr = snap.IgnoreAltGr
+	m.lastKeyFires = snap.LastKeyFires
+	m.maxComboKeys = DefaultMaxComboKeys
+	if snap.MaxComboKeys > 0 {
+		m.maxComboKeys = snap.MaxComboKeys
+	}
+	m.chordWindow = snap.ChordWindow
+	m.disabledGroups = make(map[string]bool, len(snap.DisabledGroups))
+	for _, g := range snap.DisabledGroups {
+		m.disabledGroups[g] = true
+	}
+	registry := m.registry
+	m.mu.Unlock()
+	m.SetCallbackTimeout(snap.CallbackTimeout)
+
+	var errs []error
+	for _, spec := range snap.Bindings {
+		norm, err := m.parseCombo(spec.Combo)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		spec.Callback, spec.OnRelease = registry[norm], nil
+		if spec.Callback == nil {
+			errs = append(errs, fmt.Errorf("%w: no callback registered for %q", ErrBindingNotFound, norm))
+			continue
+		}
+		if _, err := m.RegisterSpec(spec); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,45 @@
+package keyboard
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	m := NewManager(WithSuppressRepeats())
+	m.SetChordWindow(50 * time.Millisecond)
+	m.RegisterBinding("ctrl+a", func() {})
+	if _, err := m.RegisterSpec(BindingSpec{Combo: "SHIFT+B", Callback: func() {}, Group: "editor", Priority: 2}); err != nil {
+		t.Fatal(err)
+	}
+	m.DisableGroup("editor")
+	m.leds = ledState{caps: true, scroll: true}
+
+	data, err := json.Marshal(m.Snapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var snap ManagerSnapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		t.Fatal(err)
+	}
+	if want := (LockState{CapsLock: true, ScrollLock: true}); snap.Locks != want {
+		t.Errorf("Locks = %+v, want %+v", snap.Locks, want)
+	}
+
+	restored := NewManager()
+	restored.SetCallbackRegistry(map[string]BindingCallback{"CTRL+A": func() {}, "SHIFT+B": func() {}})
+	if err := restored.Restore(snap); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	got := restored.Snapshot()
+	if caps, _, _ := restored.LEDState(); caps {
+		t.Errorf("Restore changed the lock state")
+	}
+	got.Locks = snap.Locks
+	if !reflect.DeepEqual(got, snap) {
+		t.Errorf("restored snapshot = %+v, want %+v", got, snap)
+	}
+}
@@ -0,0 +1,54 @@
+package keyboard
+
+import "time"
+
+// WithStalePressTimeout makes the Manager release keys that have been
+// pressed for longer than d without a Release or Hold event, recovering
+// from lost Release events (e.g., after a kernel queue overflow). Expired
+// keys are handled as if a Release event had been received. Zero, the
+// default, disables expiry. Close stops the expiry goroutine.
+func WithStalePressTimeout(d time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.staleTimeout = d
+	}
+}
+
+// expireLoop periodically releases stale pressed keys until stop is closed.
+func (m *Manager) expireLoop(stop <-chan struct{}) {
+	interval := m.staleTimeout / 2
+	if interval <= 0 {
+		interval = m.staleTimeout
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-t.C:
+			m.expireStale(now)
+		}
+	}
+}
+
+// expireStale releases the keys last pressed or held more than the stale
+// press timeout before now.
+func (m *Manager) expireStale(now time.Time) {
+	m.mu.Lock()
+	var stale []string
+	m.keys.each(func(key string, seen time.Time) {
+		if now.Sub(seen) > m.staleTimeout {
+			stale = append(stale, key)
+		}
+	})
+	for _, key := range stale {
+		m.logger.Debug("releasing stale key", "key", key)
+		// bypass key mapping and sticky keys, which could keep it pressed
+		m.processEvent(Event{Key: key, Type: Release})
+	}
+	calls := m.pending
+	m.pending = nil
+	m.mu.Unlock()
+
+	m.dispatch(calls)
+}
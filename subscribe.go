@@ -0,0 +1,156 @@
+package keyboard
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/exp/slices"
+)
+
+// ManagerEvent is a notification of Manager activity delivered to
+// subscribers: a ComboFiredEvent, KeyPressedEvent, KeyReleasedEvent or
+// BindingRegisteredEvent.
+type ManagerEvent interface {
+	isManagerEvent()
+}
+
+// ComboFiredEvent reports that the callback of a binding for Combo fired.
+type ComboFiredEvent struct {
+	Combo string
+	At    time.Time
+}
+
+// KeyPressedEvent reports a Press event for Key.
+type KeyPressedEvent struct {
+	Key string
+}
+
+// KeyReleasedEvent reports a Release event for Key.
+type KeyReleasedEvent struct {
+	Key string
+}
+
+// BindingRegisteredEvent reports that a binding for Combo was registered.
+type BindingRegisteredEvent struct {
+	Combo string
+}
+
+func (ComboFiredEvent) isManagerEvent()        {}
+func (KeyPressedEvent) isManagerEvent()        {}
+func (KeyReleasedEvent) isManagerEvent()       {}
+func (BindingRegisteredEvent) isManagerEvent() {}
+
+// subscriberBuffer is the capacity of subscriber channels. Notifications
+// for subscribers that fall further behind are dropped, so a slow
+// subscriber never blocks event handling.
+const subscriberBuffer = 64
+
+// subscribers is the set of channels receiving ManagerEvents. It has its
+// own lock so that notifications can be published with or without m.mu.
+type subscribers struct {
+	mu      sync.Mutex
+	chs     []chan ManagerEvent
+	n       atomic.Int32    // len(chs), read by any without mu
+	matches chan ComboMatch // returned by ComboMatches, nil until requested
+	closed  bool            // matches has been closed by Close
+}
+
+// Subscribe returns a channel receiving notifications of the Manager's
+// activity, for logging, monitoring and tests. Notifications are dropped
+// while the channel's buffer is full. Call Unsubscribe to stop receiving
+// them.
+func (m *Manager) Subscribe() <-chan ManagerEvent {
+	ch := make(chan ManagerEvent, subscriberBuffer)
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	m.subs.chs = append(m.subs.chs, ch)
+	m.subs.n.Store(int32(len(m.subs.chs)))
+	return ch
+}
+
+// Unsubscribe stops notifications to ch, a channel returned by Subscribe,
+// and closes it.
+func (m *Manager) Unsubscribe(ch <-chan ManagerEvent) {
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	i := slices.IndexFunc(m.subs.chs, func(c chan ManagerEvent) bool { return c == ch })
+	if i < 0 {
+		return
+	}
+	close(m.subs.chs[i])
+	m.subs.chs = slices.Delete(m.subs.chs, i, i+1)
+	m.subs.n.Store(int32(len(m.subs.chs)))
+}
+
+// any reports whether there are subscribers, so that the event path can
+// skip building notifications, which allocate, when nobody receives them.
+func (s *subscribers) any() bool {
+	return s.n.Load() > 0
+}
+
+// publish sends ev to all subscribers without blocking.
+func (m *Manager) publish(ev ManagerEvent) {
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	for _, ch := range m.subs.chs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+// ComboMatch reports that the callback of a binding for Combo fired.
+type ComboMatch struct {
+	Combo string
+	// Callback is the position of the fired binding among the bindings
+	// registered for Combo, in the order they are tried.
+	Callback int
+	At       time.Time
+}
+
+// ComboMatches returns a channel receiving a ComboMatch whenever a binding
+// fires, for monitoring all combos without registering callbacks for each.
+// Every call returns the same channel. Matches are dropped while its buffer
+// is full, and it is closed by Close.
+func (m *Manager) ComboMatches() <-chan ComboMatch {
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	if m.subs.matches == nil {
+		m.subs.matches = make(chan ComboMatch, subscriberBuffer)
+		if m.subs.closed {
+			close(m.subs.matches)
+		}
+	}
+	return m.subs.matches
+}
+
+// notifyFired publishes that the callback of b, at position idx among the
+// bindings for its combo, fired.
+func (m *Manager) notifyFired(b *binding, idx int) {
+	now := time.Now()
+	if m.subs.any() {
+		m.publish(ComboFiredEvent{Combo: b.combo, At: now})
+	}
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	if m.subs.matches != nil && !m.subs.closed {
+		select {
+		case m.subs.matches <- ComboMatch{Combo: b.combo, Callback: idx, At: now}:
+		default:
+		}
+	}
+}
+
+// closeMatches closes the channel returned by ComboMatches.
+func (m *Manager) closeMatches() {
+	m.subs.mu.Lock()
+	defer m.subs.mu.Unlock()
+	if !m.subs.closed {
+		m.subs.closed = true
+		if m.subs.matches != nil {
+			close(m.subs.matches)
+		}
+	}
+}
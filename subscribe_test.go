@@ -0,0 +1,33 @@
+package keyboard
+
+import "testing"
+
+func TestSubscribe(t *testing.T) {
+	m := NewManager()
+	ch := m.Subscribe()
+	m.RegisterBinding("A", func() {}, SyncCallback())
+	m.HandleEvent(tap("KEY_A")...)
+	want := []ManagerEvent{
+		BindingRegisteredEvent{Combo: "A"},
+		KeyPressedEvent{Key: "KEY_A"},
+		ComboFiredEvent{Combo: "A"},
+		KeyReleasedEvent{Key: "KEY_A"},
+	}
+	for i, w := range want {
+		got := <-ch
+		if fired, ok := got.(ComboFiredEvent); ok {
+			fired.At = w.(ComboFiredEvent).At
+			got = fired
+		}
+		if got != w {
+			t.Errorf("notification %d = %#v, want %#v", i, got, w)
+		}
+	}
+	m.Unsubscribe(ch)
+	if _, open := <-ch; open {
+		t.Errorf("channel open after Unsubscribe")
+	}
+	if m.subs.any() {
+		t.Errorf("subscribers remain after Unsubscribe")
+	}
+}
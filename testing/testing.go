@@ -0,0 +1,167 @@
+// Package testing provides helpers for testing code built on the keyboard
+// package without a physical keyboard: a fluent builder for event sequences,
+// a recording FakeManager and assertions on fired callbacks.
+package testing
+
+import (
+	"sync"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+)
+
+// EventSequenceBuilder builds sequences of keyboard events fluently, e.g.
+// NewEventSequence().Press("KEY_A").Hold("KEY_A").Release("KEY_A").Build().
+type EventSequenceBuilder struct {
+	events []keyboard.Event
+}
+
+// NewEventSequence returns an empty EventSequenceBuilder.
+func NewEventSequence() *EventSequenceBuilder {
+	return &EventSequenceBuilder{}
+}
+
+// Press appends a Press event for key.
+func (b *EventSequenceBuilder) Press(key string) *EventSequenceBuilder {
+	return b.add(key, keyboard.Press)
+}
+
+// Hold appends a Hold event for key.
+func (b *EventSequenceBuilder) Hold(key string) *EventSequenceBuilder {
+	return b.add(key, keyboard.Hold)
+}
+
+// Release appends a Release event for key.
+func (b *EventSequenceBuilder) Release(key string) *EventSequenceBuilder {
+	return b.add(key, keyboard.Release)
+}
+
+func (b *EventSequenceBuilder) add(key string, et keyboard.EventType) *EventSequenceBuilder {
+	b.events = append(b.events, keyboard.Event{Key: key, Type: et})
+	return b
+}
+
+// Build returns a copy of the events appended so far.
+func (b *EventSequenceBuilder) Build() []keyboard.Event {
+	return append([]keyboard.Event(nil), b.events...)
+}
+
+// FakeManager is a keyboard.KeyboardManager that wraps a real Manager and
+// records every handled event and every fired callback.
+type FakeManager struct {
+	m *keyboard.Manager
+
+	mu     sync.Mutex
+	events []keyboard.Event
+	fired  map[string]int // normalized combo to number of fired callbacks
+	notify chan struct{}  // closed and replaced whenever a callback fires
+}
+
+var _ keyboard.KeyboardManager = (*FakeManager)(nil)
+
+// NewFakeManager returns a FakeManager wrapping a Manager created with opts.
+func NewFakeManager(opts ...keyboard.ManagerOption) *FakeManager {
+	return &FakeManager{
+		m:      keyboard.NewManager(opts...),
+		fired:  make(map[string]int),
+		notify: make(chan struct{}),
+	}
+}
+
+// Manager returns the wrapped Manager.
+func (f *FakeManager) Manager() *keyboard.Manager {
+	return f.m
+}
+
+// RegisterBinding registers cb for combo on the wrapped Manager.
+func (f *FakeManager) RegisterBinding(combo string, cb keyboard.BindingCallback, opts ...keyboard.BindingOption) {
+	spec := keyboard.BindingSpec{Combo: combo, Callback: cb}
+	for _, opt := range opts {
+		opt(&spec)
+	}
+	f.RegisterSpec(spec)
+}
+
+// RegisterSpec registers spec on the wrapped Manager, recording each time
+// its Callback fires.
+func (f *FakeManager) RegisterSpec(spec keyboard.BindingSpec) (keyboard.BindingID, error) {
+	norm, err := f.m.NormalizeCombo(spec.Combo)
+	if err != nil {
+		return 0, err
+	}
+	if cb := spec.Callback; cb != nil {
+		spec.Callback = func() {
+			f.record(norm)
+			cb()
+		}
+	}
+	return f.m.RegisterSpec(spec)
+}
+
+// UnregisterAll removes all bindings from the wrapped Manager.
+func (f *FakeManager) UnregisterAll() {
+	f.m.UnregisterAll()
+}
+
+// HandleEvent records evs and passes them to the wrapped Manager,
+// returning its error.
+func (f *FakeManager) HandleEvent(evs ...keyboard.Event) error {
+	f.mu.Lock()
+	f.events = append(f.events, evs...)
+	f.mu.Unlock()
+	return f.m.HandleEvents(evs)
+}
+
+// Events returns all events handled so far, in order.
+func (f *FakeManager) Events() []keyboard.Event {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]keyboard.Event(nil), f.events...)
+}
+
+// FiredCount returns how many times callbacks registered for combo have fired.
+func (f *FakeManager) FiredCount(combo string) int {
+	norm, err := f.m.NormalizeCombo(combo)
+	if err != nil {
+		return 0
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.fired[norm]
+}
+
+func (f *FakeManager) record(combo string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.fired[combo]++
+	close(f.notify)
+	f.notify = make(chan struct{})
+}
+
+// TB is the subset of testing.TB used by the assertions in this package.
+type TB interface {
+	Helper()
+	Fatalf(format string, args ...any)
+}
+
+// AssertCallbackFired fails the test unless a callback registered for combo
+// on m has fired, waiting up to timeout for asynchronous callbacks.
+func AssertCallbackFired(t TB, m *FakeManager, combo string, timeout time.Duration) {
+	t.Helper()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	for {
+		m.mu.Lock()
+		notify := m.notify
+		m.mu.Unlock()
+		if m.FiredCount(combo) > 0 {
+			return
+		}
+		select {
+		case <-notify:
+		case <-deadline.C:
+			t.Fatalf("callback for %q did not fire within %v", combo, timeout)
+			return
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package testing_test
+
+import (
+	"testing"
+	"time"
+
+	keyboard "github.com/VinewZ/go-evdev-keyboard"
+	kbtest "github.com/VinewZ/go-evdev-keyboard/testing"
+)
+
+func TestFakeManagerModifierAliases(t *testing.T) {
+	f := kbtest.NewFakeManager(keyboard.WithModifierAliases(map[string][]string{"HYPER": {"KEY_CAPSLOCK"}}))
+	if _, err := f.RegisterSpec(keyboard.BindingSpec{Combo: "hyper+a", Callback: func() {}}); err != nil {
+		t.Fatalf("RegisterSpec with an aliased modifier: %v", err)
+	}
+	f.HandleEvent(kbtest.NewEventSequence().Press("KEY_CAPSLOCK").Press("KEY_A").Build()...)
+	kbtest.AssertCallbackFired(t, f, "HYPER+A", time.Second)
+}
+
+func TestFakeManagerRejectsLikeManager(t *testing.T) {
+	opts := []keyboard.ManagerOption{keyboard.WithMaxComboKeys(1)}
+	f := kbtest.NewFakeManager(opts...)
+	m := keyboard.NewManager(opts...)
+	for _, combo := range []string{"A+B", "CTRL+A", "HYPER+A", "CTRL+"} {
+		spec := keyboard.BindingSpec{Combo: combo, Callback: func() {}}
+		_, fakeErr := f.RegisterSpec(spec)
+		_, err := m.RegisterSpec(spec)
+		if (fakeErr == nil) != (err == nil) {
+			t.Errorf("RegisterSpec(%q): FakeManager error %v, Manager error %v", combo, fakeErr, err)
+		}
+	}
+}
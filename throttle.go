@@ -0,0 +1,51 @@
+package keyboard
+
+import "time"
+
+// tokenBucket is a token bucket rate limiter holding at most one second
+// worth of tokens.
+type tokenBucket struct {
+	rate   float64   // tokens added per second
+	tokens float64   // currently available tokens
+	last   time.Time // last refill
+}
+
+func newTokenBucket(rate int) *tokenBucket {
+	return &tokenBucket{rate: float64(rate), tokens: float64(rate), last: time.Now()}
+}
+
+// take refills the bucket for the time elapsed until now and consumes one
+// token, reporting false if none was available.
+func (b *tokenBucket) take(now time.Time) bool {
+	b.tokens += now.Sub(b.last).Seconds() * b.rate
+	if b.tokens > b.rate {
+		b.tokens = b.rate
+	}
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// SetMaxEventsPerSecond limits HandleEvent to rate events per second, which
+// protects against stuck keys flooding the Manager with repeats. Every event
+// counts towards the rate, but only Hold events are dropped once it is
+// exceeded; Press and Release events are always processed. A rate of zero
+// or less removes the limit.
+func (m *Manager) SetMaxEventsPerSecond(rate int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if rate <= 0 {
+		m.throttle = nil
+		return
+	}
+	m.throttle = newTokenBucket(rate)
+}
+
+// DroppedEventCount returns the number of Hold events dropped because the
+// limit set with SetMaxEventsPerSecond was exceeded.
+func (m *Manager) DroppedEventCount() uint64 {
+	return m.dropped.Load()
+}
@@ -0,0 +1,77 @@
+package keyboard
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// EnableMatchTrace makes the Manager record why pressed combos fail to fire
+// a binding, for LastMatchFailure. It is disabled by default since
+// explaining a miss scans all bindings.
+func (m *Manager) EnableMatchTrace() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.matchTrace = true
+}
+
+// LastMatchFailure returns a human-readable explanation of the last pressed
+// combo that fired no binding, e.g. "combo SHIFT+A evaluated but binding
+// CTRL+A requires CTRL; CTRL not pressed". It returns an empty string if
+// match tracing is disabled or no combo has failed yet.
+func (m *Manager) LastMatchFailure() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.lastFailure
+}
+
+// explainMiss explains why pressing combo fired none of the bindings in
+// matched, which matched it but did not fire. The caller must hold m.mu.
+func (m *Manager) explainMiss(combo string, matched []*binding) string {
+	if len(matched) > 0 {
+		return fmt.Sprintf("combo %s evaluated but its bindings are in disabled groups", combo)
+	}
+	trigger := comboTrigger(combo)
+	var candidates []string
+	for other := range m.bindings {
+		if comboTrigger(other) == trigger {
+			candidates = append(candidates, other)
+		}
+	}
+	if len(candidates) == 0 {
+		return fmt.Sprintf("combo %s evaluated but no binding is triggered by %s", combo, trigger)
+	}
+	slices.Sort(candidates)
+	want := comboModifiers(candidates[0])
+	held := comboModifiers(combo)
+	var missing, extra []string
+	for _, mod := range want {
+		if !slices.Contains(held, mod) {
+			missing = append(missing, mod)
+		}
+	}
+	for _, mod := range held {
+		if !slices.Contains(want, mod) {
+			extra = append(extra, mod)
+		}
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "combo %s evaluated but binding %s", combo, candidates[0])
+	if len(missing) > 0 {
+		fmt.Fprintf(&b, " requires %s; %s not pressed", strings.Join(want, "+"), strings.Join(missing, ", "))
+	}
+	if len(extra) > 0 {
+		if len(missing) > 0 {
+			b.WriteString(";")
+		}
+		fmt.Fprintf(&b, " does not include %s", strings.Join(extra, ", "))
+	}
+	return b.String()
+}
+
+// comboModifiers returns the modifier names of a normalized combo.
+func comboModifiers(combo string) []string {
+	parts := strings.Split(combo, "+")
+	return parts[:len(parts)-1]
+}
@@ -0,0 +1,88 @@
+package keyboard
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// MaxComboModifiers is the number of modifiers above which ValidateBindings
+// reports a combo, as such combos cannot be typed comfortably.
+const MaxComboModifiers = 4
+
+// BindingError describes a problem with a registered combo found by
+// ValidateBindings. Err is one of ErrDuplicateBinding, ErrTooManyModifiers
+// or ErrShadowedBinding, possibly wrapped with details.
+type BindingError struct {
+	Combo string
+	Err   error
+}
+
+// Error implements the error interface.
+func (e BindingError) Error() string {
+	return fmt.Sprintf("binding %q: %v", e.Combo, e.Err)
+}
+
+// Unwrap returns e.Err.
+func (e BindingError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateBindings inspects the bindings registered on m and reports combos
+// with more than one binding, combos with more than MaxComboModifiers
+// modifiers and bindings shadowed by a higher-priority wildcard binding
+// (e.g., "CTRL+A" behind "*+A") that does not continue propagation. It is
+// meant as a sanity check at startup; the errors are sorted by combo.
+// Unknown key names need no check, since combos are validated when they
+// are registered.
+func ValidateBindings(m *Manager) []BindingError {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	combos := make([]string, 0, len(m.bindings))
+	for combo := range m.bindings {
+		combos = append(combos, combo)
+	}
+	slices.Sort(combos)
+
+	var errs []BindingError
+	for _, combo := range combos {
+		list := visibleBindings(m.bindings[combo])
+		if len(list) == 0 {
+			continue
+		}
+		if len(list) > 1 {
+			errs = append(errs, BindingError{combo, fmt.Errorf("%w: %d bindings", ErrDuplicateBinding, len(list))})
+		}
+		if n := m.countModifiers(combo); n > MaxComboModifiers {
+			errs = append(errs, BindingError{combo, fmt.Errorf("%w: %d", ErrTooManyModifiers, n)})
+		}
+		trigger := comboTrigger(combo)
+		if combo == wildcard+"+"+trigger {
+			continue
+		}
+		for _, w := range m.bindings[wildcard+"+"+trigger] {
+			if w.spec.ContinuePropagation {
+				continue
+			}
+			if slices.ContainsFunc(list, func(b *binding) bool { return w.spec.Priority > b.spec.Priority }) {
+				errs = append(errs, BindingError{combo, fmt.Errorf("%w by %q", ErrShadowedBinding, w.combo)})
+				break
+			}
+		}
+	}
+	return errs
+}
+
+// countModifiers returns the number of modifiers in a normalized combo,
+// including aliased ones but not wildcards or other keys. The caller must
+// hold m.mu.
+func (m *Manager) countModifiers(combo string) int {
+	n := 0
+	for _, p := range strings.Split(combo, "+") {
+		if m.isModifierName(p) {
+			n++
+		}
+	}
+	return n
+}
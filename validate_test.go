@@ -0,0 +1,28 @@
+package keyboard
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateBindingsModifierCount(t *testing.T) {
+	m := NewManager(WithModifierAliases(map[string][]string{"HYPER": {"KEY_CAPSLOCK"}}), WithMaxComboKeys(3))
+	nop := func() {}
+	for _, combo := range []string{
+		"CTRL+SHIFT+ALT+META+A",       // at the limit
+		"*+A",                         // wildcard is not a modifier
+		"CTRL+SHIFT+ALT+META+A+B",     // keys are not modifiers
+		"CTRL+SHIFT+ALT+META+HYPER+C", // alias counts
+	} {
+		if _, err := m.RegisterSpec(BindingSpec{Combo: combo, Callback: nop}); err != nil {
+			t.Fatalf("RegisterSpec(%q): %v", combo, err)
+		}
+	}
+	errs := ValidateBindings(m)
+	if len(errs) != 1 || !errors.Is(errs[0], ErrTooManyModifiers) {
+		t.Fatalf("ValidateBindings = %v, want one ErrTooManyModifiers", errs)
+	}
+	if want := "CTRL+SHIFT+ALT+META+HYPER+C"; errs[0].Combo != want {
+		t.Errorf("ValidateBindings reported %q, want %q", errs[0].Combo, want)
+	}
+}
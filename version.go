@@ -0,0 +1,39 @@
+package keyboard
+
+import "runtime/debug"
+
+// ReleaseVersion is the semantic version of this release of the package. It
+// must match the version in the installation instructions of README.md.
+const ReleaseVersion = "v1.0.0"
+
+// modulePath is the module path of this package, as recorded in build info.
+const modulePath = "github.com/VinewZ/go-evdev-keyboard"
+
+// version overrides the value returned by Version when set at build time
+// with -ldflags "-X github.com/VinewZ/go-evdev-keyboard.version=v1.2.3".
+var version string
+
+// Version returns the version of the package linked into the program, for
+// reporting in diagnostics such as a --version flag. It is the version set
+// at build time with -ldflags, or else the version of the module recorded
+// in the program's build info when it is a dependency, or else
+// ReleaseVersion.
+func Version() string {
+	if version != "" {
+		return version
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path != modulePath {
+				continue
+			}
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return dep.Replace.Version
+			}
+			if dep.Version != "" && dep.Version != "(devel)" {
+				return dep.Version
+			}
+		}
+	}
+	return ReleaseVersion
+}
@@ -0,0 +1,24 @@
+package keyboard
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestReleaseVersionMatchesReadme(t *testing.T) {
+	readme, err := os.ReadFile("README.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := modulePath + "@" + ReleaseVersion; !strings.Contains(string(readme), want) {
+		t.Errorf("README.md does not install %s", want)
+	}
+}
+
+func TestVersionDefault(t *testing.T) {
+	// tests run the package itself, which is not a dependency in build info
+	if got := Version(); got != ReleaseVersion {
+		t.Errorf("Version() = %q, want %q", got, ReleaseVersion)
+	}
+}
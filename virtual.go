@@ -0,0 +1,98 @@
+//go:build linux
+
+package keyboard
+
+import (
+	"github.com/holoplot/go-evdev"
+)
+
+// WithVirtualDevice makes Listen grab the keyboard and forward its events
+// to a new uinput device with the given name and the same capabilities,
+// which the rest of the system then reads instead. Combined with
+// WithRemapManager, this remaps keys and hides hotkeys from other
+// applications. The virtual device is removed when listening stops.
+func WithVirtualDevice(name string) ListenOption {
+	return func(c *listenConfig) {
+		c.virtualName = name
+		c.grab = true
+	}
+}
+
+// WithRemapManager makes Listen pass every key event to m before
+// forwarding it to the device created by WithVirtualDevice. Forwarded
+// events carry the key as renamed by m's MapKeys function, and key presses
+// that fire one of m's bindings are not forwarded, nor are the Hold and
+// Release events of the same key. The events are still delivered on the
+// returned channel, but must not be passed to m again.
+func WithRemapManager(m *Manager) ListenOption {
+	return func(c *listenConfig) { c.remap = m }
+}
+
+// forwarder writes the events of a grabbed keyboard to a virtual device.
+type forwarder struct {
+	out      *evdev.InputDevice
+	m        *Manager        // handles events before forwarding, may be nil
+	consumed map[string]bool // keys whose Press fired a binding of m
+}
+
+// newForwarder creates a virtual device named name cloning dev.
+func newForwarder(name string, dev *evdev.InputDevice, m *Manager) (*forwarder, error) {
+	out, err := evdev.CloneDevice(name, dev)
+	if err != nil {
+		return nil, err
+	}
+	return &forwarder{out: out, m: m, consumed: make(map[string]bool)}, nil
+}
+
+// forward writes ev to the virtual device unless a binding consumed it.
+func (f *forwarder) forward(ev *evdev.InputEvent) {
+	if kev, ok := ConvertEvent(ev); ok && f.m != nil {
+		mapped, handled := f.m.handleForward(kev)
+		switch {
+		case kev.Type == Press && handled:
+			f.consumed[kev.Key] = true
+			return
+		case f.consumed[kev.Key]:
+			if kev.Type == Release {
+				delete(f.consumed, kev.Key)
+			}
+			return
+		}
+		out := *ev
+		if code, ok := evdev.KEYFromString[mapped.Key]; ok {
+			out.Code = code
+		} else if code, ok := rawKeyCode(mapped.Key); ok {
+			out.Code = evdev.EvCode(code)
+		}
+		ev = &out
+	}
+	f.out.WriteOne(ev)
+}
+
+// close removes the virtual device.
+func (f *forwarder) close() {
+	evdev.DestroyDevice(f.out)
+	f.out.Close()
+}
+
+// handleForward handles ev like HandleEvent and returns it with its key
+// renamed by MapKeys, reporting whether it fired a binding.
+func (m *Manager) handleForward(ev Event) (Event, bool) {
+	m.mu.Lock()
+	mapped := ev
+	if m.keyMap != nil {
+		mapped.Key = m.keyMap(ev.Key)
+	}
+	m.fireHandled = false
+	m.handleEvent(ev)
+	handled := m.fireHandled
+	calls := m.pending
+	m.pending = nil
+	unknown := m.unknownKeys
+	m.unknownKeys = nil
+	m.mu.Unlock()
+
+	m.hooks.unknownKeys(unknown)
+	m.dispatch(calls)
+	return mapped, handled
+}
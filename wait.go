@@ -0,0 +1,72 @@
+package keyboard
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/exp/slices"
+)
+
+// WaitForCombo blocks until combo is pressed or ctx is done. It returns nil
+// once the combo fires and ctx.Err() if ctx is done first. Other bindings
+// for the combo keep firing as usual. The binding WaitForCombo registers
+// while it waits is not reported by String, Snapshot or ValidateBindings.
+func (m *Manager) WaitForCombo(ctx context.Context, combo string) error {
+	norm, err := m.parseCombo(combo)
+	if err != nil {
+		return err
+	}
+	fired := make(chan struct{}, 1)
+	m.mu.Lock()
+	m.nextID++
+	id := m.nextID
+	m.insertBinding(&binding{
+		id: id,
+		spec: BindingSpec{
+			Combo: combo,
+			Callback: func() {
+				select {
+				case fired <- struct{}{}:
+				default:
+				}
+			},
+			OneShot:             true,
+			Priority:            math.MaxInt,
+			ContinuePropagation: true,
+		},
+		combo:   norm,
+		trigger: comboTrigger(norm),
+		waiter:  true,
+	})
+	m.mu.Unlock()
+	select {
+	case <-fired:
+		return nil
+	case <-ctx.Done():
+		m.Unregister(id)
+		return ctx.Err()
+	}
+}
+
+// WaitForAnyKey blocks until any key is pressed or ctx is done, and returns
+// the Press event. The event is still handled normally, so bindings for it
+// fire as usual. If ctx is done first, it returns ctx.Err().
+func (m *Manager) WaitForAnyKey(ctx context.Context) (Event, error) {
+	w := make(chan Event, 1)
+	m.mu.Lock()
+	m.keyWaiters = append(m.keyWaiters, w)
+	m.mu.Unlock()
+	select {
+	case ev := <-w:
+		return ev, nil
+	case <-ctx.Done():
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if i := slices.Index(m.keyWaiters, w); i >= 0 {
+			m.keyWaiters = slices.Delete(m.keyWaiters, i, i+1)
+			return Event{}, ctx.Err()
+		}
+		// a Press arrived while ctx was being cancelled
+		return <-w, nil
+	}
+}
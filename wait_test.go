@@ -0,0 +1,92 @@
+package keyboard
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// waitForCombo runs WaitForCombo for combo in a goroutine and feeds evs to
+// m until the call returns, returning its error.
+func waitForCombo(t *testing.T, m *Manager, combo string, evs []Event) error {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	done := make(chan error, 1)
+	go func() { done <- m.WaitForCombo(ctx, combo) }()
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-time.After(time.Millisecond):
+			m.HandleEvent(evs...)
+		}
+	}
+}
+
+func TestWaitForComboBeforeWildcard(t *testing.T) {
+	m := NewManager()
+	var wild atomic.Int64
+	spec := countingSpec("*+A", &wild)
+	spec.Priority, spec.Mode = -1, Sync
+	if _, err := m.RegisterSpec(spec); err != nil {
+		t.Fatal(err)
+	}
+	ctrlA := []Event{press("KEY_LEFTCTRL"), press("KEY_A"), release("KEY_A"), release("KEY_LEFTCTRL")}
+	if err := waitForCombo(t, m, "CTRL+A", ctrlA); err != nil {
+		t.Fatalf("WaitForCombo: %v", err)
+	}
+	if wild.Load() == 0 {
+		t.Errorf("*+A did not fire while WaitForCombo was waiting")
+	}
+	if got := m.GroupBindings(""); len(got) != 1 || got[0] != "*+A" {
+		t.Errorf("bindings after WaitForCombo = %v, want [*+A]", got)
+	}
+}
+
+func TestWaitForComboCancelled(t *testing.T) {
+	m := NewManager()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := m.WaitForCombo(ctx, "CTRL+A"); err != context.Canceled {
+		t.Fatalf("WaitForCombo = %v, want context.Canceled", err)
+	}
+	if len(m.bindings) != 0 {
+		t.Errorf("%d combos still registered after cancellation", len(m.bindings))
+	}
+}
+
+func TestWaitForComboHidden(t *testing.T) {
+	m := NewManager()
+	m.RegisterBinding("CTRL+B", func() {})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for range 2 {
+		go m.WaitForCombo(ctx, "CTRL+A")
+	}
+	deadline := time.Now().Add(time.Second)
+	for {
+		m.mu.RLock()
+		n := len(m.bindings["CTRL+A"])
+		m.mu.RUnlock()
+		if n == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("WaitForCombo did not register its bindings")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	if errs := ValidateBindings(m); len(errs) != 0 {
+		t.Errorf("ValidateBindings = %v, want none", errs)
+	}
+	if s := m.String(); strings.Contains(s, "CTRL+A") || !strings.Contains(s, "bindings: 1 ") {
+		t.Errorf("String() = %q, want only CTRL+B", s)
+	}
+	if snap := m.Snapshot(); len(snap.Bindings) != 1 || snap.Bindings[0].Combo != "CTRL+B" {
+		t.Errorf("Snapshot().Bindings = %+v, want only CTRL+B", snap.Bindings)
+	}
+}